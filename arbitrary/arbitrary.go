@@ -0,0 +1,141 @@
+// Package arbitrary generates random values of the types of `mo`, for property-based
+// testing and fuzzing.
+//
+// An Arbitrary bundles a generator and a shrinker: the generator builds a random value
+// from a `*rand.Rand`, the shrinker proposes smaller candidates of a failing value so
+// that a property failure is reported with a minimal counterexample.
+//
+// Generators are plain `func(*rand.Rand) T`, so they can be handed to the `lawtest` package.
+package arbitrary
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+const (
+	// DefaultIterations is the number of values checked when Config.Iterations is zero.
+	DefaultIterations = 100
+	// DefaultMaxShrinks is the number of shrinking steps when Config.MaxShrinks is zero.
+	DefaultMaxShrinks = 1000
+	// DefaultFuzzSeeds is the number of seeds added to the fuzzing corpus by Fuzz.
+	DefaultFuzzSeeds = 16
+)
+
+// Arbitrary generates random values of type T and shrinks them.
+type Arbitrary[T any] struct {
+	// Generate builds a random value.
+	Generate func(r *rand.Rand) T
+	// Shrink returns smaller candidates of a value, from the smallest to the largest.
+	// It may be nil when values cannot be shrunk.
+	Shrink func(value T) []T
+}
+
+// New builds an Arbitrary from a generator and an optional shrinker.
+func New[T any](generate func(r *rand.Rand) T, shrink func(value T) []T) Arbitrary[T] {
+	return Arbitrary[T]{
+		Generate: generate,
+		Shrink:   shrink,
+	}
+}
+
+// Sample builds the value generated from seed. The same seed always yields the same value.
+func (a Arbitrary[T]) Sample(seed int64) T {
+	return a.Generate(rand.New(rand.NewSource(seed)))
+}
+
+func (a Arbitrary[T]) shrink(value T) []T {
+	if a.Shrink == nil {
+		return nil
+	}
+	return a.Shrink(value)
+}
+
+// Config holds the settings of a property check.
+type Config struct {
+	// Iterations is the number of values checked. Defaults to DefaultIterations.
+	Iterations int
+	// Seed of the random generator. A time-based seed is used when zero.
+	Seed int64
+	// MaxShrinks is the number of shrinking steps. Defaults to DefaultMaxShrinks.
+	MaxShrinks int
+}
+
+// Property describes a predicate that must hold for every generated value.
+type Property[T any] struct {
+	Config
+
+	Arbitrary Arbitrary[T]
+	Predicate func(value T) bool
+}
+
+// Check verifies a property against generated values. On failure, the value is shrunk
+// and the minimal counterexample is reported with the seed reproducing it.
+func Check[T any](t testing.TB, p Property[T]) {
+	t.Helper()
+
+	iterations := p.Iterations
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+	seed := p.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	r := rand.New(rand.NewSource(seed))
+
+	for i := 0; i < iterations; i++ {
+		value := p.Arbitrary.Generate(r)
+		if p.Predicate(value) {
+			continue
+		}
+
+		shrunk, steps := Minimize(p.Arbitrary, value, p.Predicate, p.MaxShrinks)
+		t.Errorf("property failed (seed %d, iteration %d):\n  value:  %v\n  shrunk: %v (%d steps)", seed, i, value, shrunk, steps)
+		return
+	}
+}
+
+// Minimize shrinks a value failing the predicate, until none of its candidates fails.
+// It returns the smallest failing value found and the number of steps taken.
+func Minimize[T any](a Arbitrary[T], value T, predicate func(T) bool, maxShrinks int) (T, int) {
+	if maxShrinks <= 0 {
+		maxShrinks = DefaultMaxShrinks
+	}
+
+	steps := 0
+	for steps < maxShrinks {
+		shrunk := false
+
+		for _, candidate := range a.shrink(value) {
+			if !predicate(candidate) {
+				value = candidate
+				shrunk = true
+				break
+			}
+		}
+
+		if !shrunk {
+			break
+		}
+		steps++
+	}
+
+	return value, steps
+}
+
+// Fuzz plugs an Arbitrary into native Go fuzzing. The fuzzing engine mutates a seed,
+// from which a value is generated and handed to the fuzz target.
+func Fuzz[T any](f *testing.F, a Arbitrary[T], target func(t *testing.T, value T)) {
+	f.Helper()
+
+	for seed := int64(0); seed < DefaultFuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		target(t, a.Sample(seed))
+	})
+}
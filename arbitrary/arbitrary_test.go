@@ -0,0 +1,141 @@
+package arbitrary
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder captures failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestSample(t *testing.T) {
+	is := assert.New(t)
+
+	a := Slice(Int())
+
+	is.Equal(a.Sample(42), a.Sample(42))
+	is.NotEqual(a.Sample(1), a.Sample(2))
+}
+
+func TestCheck(t *testing.T) {
+	is := assert.New(t)
+
+	rec := &recorder{TB: t}
+	Check(rec, Property[int]{
+		Arbitrary: IntRange(0, 1000),
+		Predicate: func(i int) bool {
+			return i >= 0 && i <= 1000
+		},
+	})
+	is.Empty(rec.failures)
+
+	rec = &recorder{TB: t}
+	Check(rec, Property[int]{
+		Config:    Config{Seed: 42},
+		Arbitrary: IntRange(0, 1000),
+		Predicate: func(i int) bool {
+			return i < 50
+		},
+	})
+	is.Len(rec.failures, 1)
+	is.Contains(rec.failures[0], "property failed (seed 42")
+	is.Contains(rec.failures[0], "shrunk: 50 (")
+}
+
+func TestMinimize(t *testing.T) {
+	is := assert.New(t)
+
+	value, steps := Minimize(Slice(Int()), []int{4, -12, 300, 7}, func(s []int) bool {
+		for _, i := range s {
+			if i > 100 {
+				return false
+			}
+		}
+		return true
+	}, 0)
+	is.Equal([]int{101}, value)
+	is.Greater(steps, 0)
+
+	value, steps = Minimize(Slice(Int()), []int{300}, func(s []int) bool {
+		return false
+	}, 1)
+	is.Equal([]int{}, value)
+	is.Equal(1, steps)
+}
+
+func TestConstAndOneOf(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal("foo", Const("foo").Sample(42))
+	is.Nil(Const("foo").shrink("foo"))
+
+	a := OneOf("foo", "bar", "baz")
+	is.Contains([]string{"foo", "bar", "baz"}, a.Sample(42))
+	is.Equal([]string{"foo"}, a.shrink("baz"))
+	is.Nil(a.shrink("foo"))
+
+	is.PanicsWithValue("arbitrary: OneOf requires at least one value", func() {
+		OneOf[string]()
+	})
+}
+
+func TestBool(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal([]bool{false}, Bool().shrink(true))
+	is.Nil(Bool().shrink(false))
+}
+
+func TestIntRange(t *testing.T) {
+	is := assert.New(t)
+
+	Check(t, Property[int]{
+		Arbitrary: IntRange(-5, 5),
+		Predicate: func(i int) bool {
+			return i >= -5 && i <= 5
+		},
+	})
+
+	is.Nil(Int().shrink(0))
+	is.Equal([]int{0, 5, 8, 9}, Int().shrink(10))
+	is.Equal([]int{0, -5, -8, -9}, Int().shrink(-10))
+	is.Equal([]int{10, 11}, IntRange(10, 20).shrink(12))
+	is.Equal([]int{-10, -11}, IntRange(-20, -10).shrink(-12))
+
+	is.Equal(7, IntRange(7, 7).Sample(42))
+	is.PanicsWithValue("arbitrary: IntRange requires min <= max", func() {
+		IntRange(5, -5)
+	})
+}
+
+func TestString(t *testing.T) {
+	is := assert.New(t)
+
+	Check(t, Property[string]{
+		Arbitrary: String(),
+		Predicate: func(s string) bool {
+			return len(s) <= 16
+		},
+	})
+
+	is.Nil(String().shrink(""))
+	is.Equal([]string{"", "f", "oo", "fo", "fo"}, String().shrink("foo"))
+}
+
+func TestError(t *testing.T) {
+	is := assert.New(t)
+
+	is.Error(Error().Sample(42))
+	is.Nil(Error().shrink(assert.AnError))
+}
@@ -0,0 +1,199 @@
+package arbitrary
+
+import (
+	"math/rand"
+
+	"github.com/tperdue321/mo"
+)
+
+// Option generates None one time out of four, and Some values from value otherwise.
+// Some values are shrunk to None, then to Some of a shrunk value.
+func Option[T any](value Arbitrary[T]) Arbitrary[mo.Option[T]] {
+	return New(func(r *rand.Rand) mo.Option[T] {
+		if r.Intn(4) == 0 {
+			return mo.None[T]()
+		}
+		return mo.Some(value.Generate(r))
+	}, func(o mo.Option[T]) []mo.Option[T] {
+		v, ok := o.Get()
+		if !ok {
+			return nil
+		}
+
+		candidates := []mo.Option[T]{mo.None[T]()}
+		for _, shrunk := range value.shrink(v) {
+			candidates = append(candidates, mo.Some(shrunk))
+		}
+		return candidates
+	})
+}
+
+// Result generates Err values from err one time out of four, and Ok values from value otherwise.
+// Ok values are shrunk to Ok of a shrunk value.
+func Result[T any](value Arbitrary[T], err Arbitrary[error]) Arbitrary[mo.Result[T]] {
+	return New(func(r *rand.Rand) mo.Result[T] {
+		if r.Intn(4) == 0 {
+			return mo.Err[T](err.Generate(r))
+		}
+		return mo.Ok(value.Generate(r))
+	}, func(res mo.Result[T]) []mo.Result[T] {
+		if res.IsError() {
+			var candidates []mo.Result[T]
+			for _, shrunk := range err.shrink(res.Error()) {
+				candidates = append(candidates, mo.Err[T](shrunk))
+			}
+			return candidates
+		}
+
+		var candidates []mo.Result[T]
+		for _, shrunk := range value.shrink(res.MustGet()) {
+			candidates = append(candidates, mo.Ok(shrunk))
+		}
+		return candidates
+	})
+}
+
+// Either generates Left and Right values evenly. Values are shrunk within their side.
+func Either[L any, R any](left Arbitrary[L], right Arbitrary[R]) Arbitrary[mo.Either[L, R]] {
+	return New(func(r *rand.Rand) mo.Either[L, R] {
+		if r.Intn(2) == 0 {
+			return mo.Left[L, R](left.Generate(r))
+		}
+		return mo.Right[L](right.Generate(r))
+	}, func(e mo.Either[L, R]) []mo.Either[L, R] {
+		var candidates []mo.Either[L, R]
+		if v, ok := e.Left(); ok {
+			for _, shrunk := range left.shrink(v) {
+				candidates = append(candidates, mo.Left[L, R](shrunk))
+			}
+		} else {
+			for _, shrunk := range right.shrink(e.MustRight()) {
+				candidates = append(candidates, mo.Right[L](shrunk))
+			}
+		}
+		return candidates
+	})
+}
+
+// Either3 generates each argument of Either3 evenly. Values are shrunk within their argument.
+func Either3[T1 any, T2 any, T3 any](a1 Arbitrary[T1], a2 Arbitrary[T2], a3 Arbitrary[T3]) Arbitrary[mo.Either3[T1, T2, T3]] {
+	return New(func(r *rand.Rand) mo.Either3[T1, T2, T3] {
+		switch r.Intn(3) {
+		case 0:
+			return mo.NewEither3Arg1[T1, T2, T3](a1.Generate(r))
+		case 1:
+			return mo.NewEither3Arg2[T1, T2, T3](a2.Generate(r))
+		default:
+			return mo.NewEither3Arg3[T1, T2](a3.Generate(r))
+		}
+	}, func(e mo.Either3[T1, T2, T3]) []mo.Either3[T1, T2, T3] {
+		var candidates []mo.Either3[T1, T2, T3]
+		e.ForEach(
+			func(v T1) {
+				for _, shrunk := range a1.shrink(v) {
+					candidates = append(candidates, mo.NewEither3Arg1[T1, T2, T3](shrunk))
+				}
+			},
+			func(v T2) {
+				for _, shrunk := range a2.shrink(v) {
+					candidates = append(candidates, mo.NewEither3Arg2[T1, T2, T3](shrunk))
+				}
+			},
+			func(v T3) {
+				for _, shrunk := range a3.shrink(v) {
+					candidates = append(candidates, mo.NewEither3Arg3[T1, T2](shrunk))
+				}
+			},
+		)
+		return candidates
+	})
+}
+
+// Either4 generates each argument of Either4 evenly. Values are shrunk within their argument.
+func Either4[T1 any, T2 any, T3 any, T4 any](a1 Arbitrary[T1], a2 Arbitrary[T2], a3 Arbitrary[T3], a4 Arbitrary[T4]) Arbitrary[mo.Either4[T1, T2, T3, T4]] {
+	return New(func(r *rand.Rand) mo.Either4[T1, T2, T3, T4] {
+		switch r.Intn(4) {
+		case 0:
+			return mo.NewEither4Arg1[T1, T2, T3, T4](a1.Generate(r))
+		case 1:
+			return mo.NewEither4Arg2[T1, T2, T3, T4](a2.Generate(r))
+		case 2:
+			return mo.NewEither4Arg3[T1, T2, T3, T4](a3.Generate(r))
+		default:
+			return mo.NewEither4Arg4[T1, T2, T3](a4.Generate(r))
+		}
+	}, func(e mo.Either4[T1, T2, T3, T4]) []mo.Either4[T1, T2, T3, T4] {
+		var candidates []mo.Either4[T1, T2, T3, T4]
+		e.ForEach(
+			func(v T1) {
+				for _, shrunk := range a1.shrink(v) {
+					candidates = append(candidates, mo.NewEither4Arg1[T1, T2, T3, T4](shrunk))
+				}
+			},
+			func(v T2) {
+				for _, shrunk := range a2.shrink(v) {
+					candidates = append(candidates, mo.NewEither4Arg2[T1, T2, T3, T4](shrunk))
+				}
+			},
+			func(v T3) {
+				for _, shrunk := range a3.shrink(v) {
+					candidates = append(candidates, mo.NewEither4Arg3[T1, T2, T3, T4](shrunk))
+				}
+			},
+			func(v T4) {
+				for _, shrunk := range a4.shrink(v) {
+					candidates = append(candidates, mo.NewEither4Arg4[T1, T2, T3](shrunk))
+				}
+			},
+		)
+		return candidates
+	})
+}
+
+// Either5 generates each argument of Either5 evenly. Values are shrunk within their argument.
+func Either5[T1 any, T2 any, T3 any, T4 any, T5 any](a1 Arbitrary[T1], a2 Arbitrary[T2], a3 Arbitrary[T3], a4 Arbitrary[T4], a5 Arbitrary[T5]) Arbitrary[mo.Either5[T1, T2, T3, T4, T5]] {
+	return New(func(r *rand.Rand) mo.Either5[T1, T2, T3, T4, T5] {
+		switch r.Intn(5) {
+		case 0:
+			return mo.NewEither5Arg1[T1, T2, T3, T4, T5](a1.Generate(r))
+		case 1:
+			return mo.NewEither5Arg2[T1, T2, T3, T4, T5](a2.Generate(r))
+		case 2:
+			return mo.NewEither5Arg3[T1, T2, T3, T4, T5](a3.Generate(r))
+		case 3:
+			return mo.NewEither5Arg4[T1, T2, T3, T4, T5](a4.Generate(r))
+		default:
+			return mo.NewEither5Arg5[T1, T2, T3, T4](a5.Generate(r))
+		}
+	}, func(e mo.Either5[T1, T2, T3, T4, T5]) []mo.Either5[T1, T2, T3, T4, T5] {
+		var candidates []mo.Either5[T1, T2, T3, T4, T5]
+		e.ForEach(
+			func(v T1) {
+				for _, shrunk := range a1.shrink(v) {
+					candidates = append(candidates, mo.NewEither5Arg1[T1, T2, T3, T4, T5](shrunk))
+				}
+			},
+			func(v T2) {
+				for _, shrunk := range a2.shrink(v) {
+					candidates = append(candidates, mo.NewEither5Arg2[T1, T2, T3, T4, T5](shrunk))
+				}
+			},
+			func(v T3) {
+				for _, shrunk := range a3.shrink(v) {
+					candidates = append(candidates, mo.NewEither5Arg3[T1, T2, T3, T4, T5](shrunk))
+				}
+			},
+			func(v T4) {
+				for _, shrunk := range a4.shrink(v) {
+					candidates = append(candidates, mo.NewEither5Arg4[T1, T2, T3, T4, T5](shrunk))
+				}
+			},
+			func(v T5) {
+				for _, shrunk := range a5.shrink(v) {
+					candidates = append(candidates, mo.NewEither5Arg5[T1, T2, T3, T4](shrunk))
+				}
+			},
+		)
+		return candidates
+	})
+}
@@ -0,0 +1,101 @@
+package arbitrary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo"
+)
+
+func TestOption(t *testing.T) {
+	is := assert.New(t)
+
+	a := Option(IntRange(0, 10))
+
+	present := 0
+	for seed := int64(0); seed < 100; seed++ {
+		present += a.Sample(seed).Size()
+	}
+	is.Greater(present, 50)
+	is.Less(present, 100)
+
+	is.Nil(a.shrink(mo.None[int]()))
+	is.Equal([]mo.Option[int]{mo.None[int](), mo.Some(0), mo.Some(1)}, a.shrink(mo.Some(2)))
+}
+
+func TestResult(t *testing.T) {
+	is := assert.New(t)
+
+	a := Result(IntRange(0, 10), Error())
+
+	ok := 0
+	for seed := int64(0); seed < 100; seed++ {
+		if a.Sample(seed).IsOk() {
+			ok++
+		}
+	}
+	is.Greater(ok, 50)
+	is.Less(ok, 100)
+
+	is.Nil(a.shrink(mo.Err[int](assert.AnError)))
+	is.Equal([]mo.Result[int]{mo.Ok(0), mo.Ok(1)}, a.shrink(mo.Ok(2)))
+}
+
+func TestEither(t *testing.T) {
+	is := assert.New(t)
+
+	a := Either(Bool(), IntRange(0, 10))
+
+	left := 0
+	for seed := int64(0); seed < 100; seed++ {
+		if a.Sample(seed).IsLeft() {
+			left++
+		}
+	}
+	is.Greater(left, 0)
+	is.Less(left, 100)
+
+	is.Equal([]mo.Either[bool, int]{mo.Left[bool, int](false)}, a.shrink(mo.Left[bool, int](true)))
+	is.Equal([]mo.Either[bool, int]{mo.Right[bool](0), mo.Right[bool](1)}, a.shrink(mo.Right[bool](2)))
+}
+
+func TestEitherX(t *testing.T) {
+	is := assert.New(t)
+
+	a3 := Either3(Bool(), Const("foo"), IntRange(0, 10))
+	a4 := Either4(Bool(), Const("foo"), Const(4.2), IntRange(0, 10))
+	a5 := Either5(Bool(), Const("foo"), Const(4.2), Const(byte(42)), IntRange(0, 10))
+
+	seen3, seen4, seen5 := map[bool]int{}, map[bool]int{}, map[bool]int{}
+	for seed := int64(0); seed < 100; seed++ {
+		seen3[a3.Sample(seed).IsArg3()]++
+		seen4[a4.Sample(seed).IsArg4()]++
+		seen5[a5.Sample(seed).IsArg5()]++
+	}
+	is.Len(seen3, 2)
+	is.Len(seen4, 2)
+	is.Len(seen5, 2)
+
+	is.Equal(
+		[]mo.Either3[bool, string, int]{mo.NewEither3Arg1[bool, string, int](false)},
+		a3.shrink(mo.NewEither3Arg1[bool, string, int](true)),
+	)
+	is.Nil(a3.shrink(mo.NewEither3Arg2[bool, string, int]("foo")))
+	is.Equal(
+		[]mo.Either4[bool, string, float64, int]{mo.NewEither4Arg4[bool, string, float64](0), mo.NewEither4Arg4[bool, string, float64](1)},
+		a4.shrink(mo.NewEither4Arg4[bool, string, float64](2)),
+	)
+	is.Equal(
+		[]mo.Either5[bool, string, float64, byte, int]{mo.NewEither5Arg5[bool, string, float64, byte](0), mo.NewEither5Arg5[bool, string, float64, byte](1)},
+		a5.shrink(mo.NewEither5Arg5[bool, string, float64, byte](2)),
+	)
+}
+
+func FuzzOption(f *testing.F) {
+	Fuzz(f, Option(Int()), func(t *testing.T, value mo.Option[int]) {
+		if v, ok := value.Get(); ok && (v < -1000 || v > 1000) {
+			t.Errorf("unexpected value %d", v)
+		}
+		assert.Equal(t, value.IsPresent(), value.Size() == 1)
+	})
+}
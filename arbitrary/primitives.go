@@ -0,0 +1,155 @@
+package arbitrary
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+)
+
+// Const always generates the same value.
+func Const[T any](value T) Arbitrary[T] {
+	return New(func(r *rand.Rand) T {
+		return value
+	}, nil)
+}
+
+// OneOf picks one of the given values. Values are shrunk toward the first one. It panics
+// when no value is given.
+func OneOf[T any](values ...T) Arbitrary[T] {
+	if len(values) == 0 {
+		panic("arbitrary: OneOf requires at least one value")
+	}
+
+	return New(func(r *rand.Rand) T {
+		return values[r.Intn(len(values))]
+	}, func(value T) []T {
+		if reflect.DeepEqual(value, values[0]) {
+			return nil
+		}
+		return values[:1]
+	})
+}
+
+// Bool generates booleans. true is shrunk to false.
+func Bool() Arbitrary[bool] {
+	return New(func(r *rand.Rand) bool {
+		return r.Intn(2) == 0
+	}, func(value bool) []bool {
+		if value {
+			return []bool{false}
+		}
+		return nil
+	})
+}
+
+// Int generates integers between -1000 and 1000. Values are shrunk toward zero.
+func Int() Arbitrary[int] {
+	return IntRange(-1000, 1000)
+}
+
+// IntRange generates integers between min and max included. Values are shrunk toward
+// zero, or toward the bound closest to zero when zero is out of range. It panics
+// when min is greater than max.
+func IntRange(min int, max int) Arbitrary[int] {
+	if min > max {
+		panic("arbitrary: IntRange requires min <= max")
+	}
+
+	target := 0
+	if min > 0 {
+		target = min
+	} else if max < 0 {
+		target = max
+	}
+
+	return New(func(r *rand.Rand) int {
+		return min + r.Intn(max-min+1)
+	}, func(value int) []int {
+		return shrinkInt(value, target)
+	})
+}
+
+func shrinkInt(value int, target int) []int {
+	if value == target {
+		return nil
+	}
+
+	candidates := []int{target}
+	for distance := (value - target) / 2; distance != 0; distance /= 2 {
+		candidates = append(candidates, value-distance)
+	}
+	closest := value + 1
+	if value > target {
+		closest = value - 1
+	}
+	if candidates[len(candidates)-1] != closest {
+		candidates = append(candidates, closest)
+	}
+
+	return candidates
+}
+
+const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// String generates alphanumeric strings up to 16 characters. Values are shrunk by
+// removing characters.
+func String() Arbitrary[string] {
+	return New(func(r *rand.Rand) string {
+		b := make([]byte, r.Intn(17))
+		for i := range b {
+			b[i] = alphabet[r.Intn(len(alphabet))]
+		}
+		return string(b)
+	}, func(value string) []string {
+		if value == "" {
+			return nil
+		}
+
+		candidates := []string{"", value[:len(value)/2]}
+		for i := range value {
+			candidates = append(candidates, value[:i]+value[i+1:])
+		}
+		return candidates
+	})
+}
+
+// Error generates errors with a random message. Errors are not shrunk.
+func Error() Arbitrary[error] {
+	messages := String()
+
+	return New(func(r *rand.Rand) error {
+		return errors.New("error " + messages.Generate(r))
+	}, nil)
+}
+
+// Slice generates slices up to 16 items. Values are shrunk by removing items, then by
+// shrinking each item.
+func Slice[T any](item Arbitrary[T]) Arbitrary[[]T] {
+	return New(func(r *rand.Rand) []T {
+		result := make([]T, r.Intn(17))
+		for i := range result {
+			result[i] = item.Generate(r)
+		}
+		return result
+	}, func(value []T) [][]T {
+		if len(value) == 0 {
+			return nil
+		}
+
+		candidates := [][]T{{}, value[:len(value)/2]}
+		for i := range value {
+			candidate := make([]T, 0, len(value)-1)
+			candidate = append(candidate, value[:i]...)
+			candidates = append(candidates, append(candidate, value[i+1:]...))
+		}
+		for i := range value {
+			for _, shrunk := range item.shrink(value[i]) {
+				candidate := make([]T, len(value))
+				copy(candidate, value)
+				candidate[i] = shrunk
+				candidates = append(candidates, candidate)
+			}
+		}
+		return candidates
+	})
+}
@@ -0,0 +1,130 @@
+package mo
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// BracketIOEither returns an IOEither acquiring a resource, using it and releasing it.
+// release is called once the resource is acquired, whether use succeeds, fails or panics,
+// and receives the error of use so that it can commit or roll back. The IOEither fails
+// with the errors of use and release combined.
+func BracketIOEither[A any, R any](acquire IOEither[A], use func(A) IOEither[R], release func(resource A, err error) error) IOEither[R] {
+	return NewIOEither(func() (R, error) {
+		resource, err := acquire.run()
+		if err != nil {
+			return empty[R](), err
+		}
+
+		var value R
+		err = catchPanic(func() (err error) {
+			value, err = use(resource).run()
+			return err
+		})
+
+		err = joinErrors(err, catchPanic(func() error {
+			return release(resource, err)
+		}))
+		if err != nil {
+			return empty[R](), err
+		}
+		return value, nil
+	})
+}
+
+// BracketTaskEither returns a TaskEither acquiring a resource, using it and releasing it.
+// release is called once the resource is acquired, whether use succeeds, fails, panics or
+// the Future is cancelled, and receives the error of use so that it can commit or roll back.
+// The Future is rejected with the errors of use and release combined, use failing with
+// context.Canceled on cancellation.
+func BracketTaskEither[A any, R any](acquire TaskEither[A], use func(A) TaskEither[R], release func(resource A, err error) error) TaskEither[R] {
+	return NewTaskEither(func() *Future[R] {
+		cancelled := make(chan struct{})
+		var cancelOnce sync.Once
+
+		return newFutureWithCancel(func(resolve func(R), reject func(error)) {
+			resource, err := acquire.Run().Collect()
+			if err != nil {
+				reject(err)
+				return
+			}
+
+			var value R
+			err = catchPanic(func() error {
+				select {
+				case <-cancelled:
+					return context.Canceled
+				default:
+				}
+
+				used := use(resource).Run()
+				select {
+				case <-used.done:
+					var err error
+					value, err = used.Collect()
+					return err
+				case <-cancelled:
+					used.Cancel()
+					return context.Canceled
+				}
+			})
+
+			err = joinErrors(err, catchPanic(func() error {
+				return release(resource, err)
+			}))
+			if err != nil {
+				reject(err)
+				return
+			}
+			resolve(value)
+		}, newRunOptions(nil), func() {
+			cancelOnce.Do(func() {
+				close(cancelled)
+			})
+		})
+	})
+}
+
+// catchPanic calls f, turning its panic into a *PanicError.
+func catchPanic(f func() error) (err error) {
+	defer recoverPanic(func(panicErr error) {
+		err = panicErr
+	})
+	return f()
+}
+
+// joinErrors combines the non-nil errors. It returns nil when there is none.
+func joinErrors(errs ...error) error {
+	nonNil := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+
+	switch len(nonNil) {
+	case 0:
+		return nil
+	case 1:
+		return nonNil[0]
+	default:
+		return &joinedError{errs: nonNil}
+	}
+}
+
+type joinedError struct {
+	errs []error
+}
+
+func (e *joinedError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *joinedError) Unwrap() []error {
+	return e.errs
+}
@@ -0,0 +1,159 @@
+package mo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errRelease = errors.New("release failed")
+
+// testResource records how it was released.
+type testResource struct {
+	released   int
+	releaseErr error
+}
+
+func (r *testResource) release(err error) error {
+	r.released++
+	r.releaseErr = err
+	return nil
+}
+
+func TestBracketIOEither(t *testing.T) {
+	is := assert.New(t)
+
+	resource := &testResource{}
+	acquire := NewIOEither(func() (*testResource, error) {
+		return resource, nil
+	})
+	release := func(r *testResource, err error) error {
+		return r.release(err)
+	}
+
+	bracket := BracketIOEither(acquire, func(r *testResource) IOEither[int] {
+		return NewIOEitherFromResult(Ok(42))
+	}, release)
+	is.Equal(0, resource.released)
+
+	is.Equal(Right[error, int](42), bracket.Run())
+	is.Equal(1, resource.released)
+	is.Nil(resource.releaseErr)
+
+	is.Equal(Left[error, int](assert.AnError), BracketIOEither(acquire, func(r *testResource) IOEither[int] {
+		return NewIOEitherFromResult(Err[int](assert.AnError))
+	}, release).Run())
+	is.Equal(2, resource.released)
+	is.Equal(assert.AnError, resource.releaseErr)
+
+	result := BracketIOEither(acquire, func(r *testResource) IOEither[int] {
+		panic("boom")
+	}, release).Run()
+	is.Equal(3, resource.released)
+	is.IsType(&PanicError{}, result.MustLeft())
+	is.IsType(&PanicError{}, resource.releaseErr)
+}
+
+func TestBracketIOEitherErrors(t *testing.T) {
+	is := assert.New(t)
+
+	var released bool
+	result := BracketIOEither(NewIOEitherFromResult(Err[int](assert.AnError)), func(r int) IOEither[int] {
+		is.Fail("should not enter here")
+		return NewIOEitherFromResult(Ok(r))
+	}, func(r int, err error) error {
+		released = true
+		return nil
+	}).Run()
+	is.Equal(Left[error, int](assert.AnError), result)
+	is.False(released)
+
+	result = BracketIOEither(NewIOEitherFromResult(Ok(1)), func(r int) IOEither[int] {
+		return NewIOEitherFromResult(Err[int](assert.AnError))
+	}, func(r int, err error) error {
+		return errRelease
+	}).Run()
+
+	err := result.MustLeft()
+	is.ErrorIs(err, assert.AnError)
+	is.ErrorIs(err, errRelease)
+	is.Equal("assert.AnError general error for testing\nrelease failed", err.Error())
+
+	result = BracketIOEither(NewIOEitherFromResult(Ok(1)), func(r int) IOEither[int] {
+		return NewIOEitherFromResult(Ok(r))
+	}, func(r int, err error) error {
+		return errRelease
+	}).Run()
+	is.Equal(Left[error, int](errRelease), result)
+}
+
+func TestBracketTaskEither(t *testing.T) {
+	is := assert.New(t)
+
+	resource := &testResource{}
+	acquire := NewTaskEitherFromFunc(func() (*testResource, error) {
+		return resource, nil
+	})
+	release := func(r *testResource, err error) error {
+		return r.release(err)
+	}
+
+	value, err := BracketTaskEither(acquire, func(r *testResource) TaskEither[int] {
+		return NewTaskEitherFromResult(Ok(42))
+	}, release).Run().Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+	is.Equal(1, resource.released)
+
+	_, err = BracketTaskEither(acquire, func(r *testResource) TaskEither[int] {
+		return NewTaskEitherFromResult(Err[int](assert.AnError))
+	}, func(r *testResource, err error) error {
+		_ = r.release(err)
+		return errRelease
+	}).Run().Collect()
+	is.ErrorIs(err, assert.AnError)
+	is.ErrorIs(err, errRelease)
+	is.Equal(2, resource.released)
+
+	_, err = BracketTaskEither(acquire, func(r *testResource) TaskEither[int] {
+		panic("boom")
+	}, release).Run().Collect()
+	is.IsType(&PanicError{}, err)
+	is.Equal(3, resource.released)
+}
+
+func TestBracketTaskEitherCancel(t *testing.T) {
+	is := assert.New(t)
+
+	resource := &testResource{}
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	release := make(chan struct{})
+
+	future := BracketTaskEither(NewTaskEitherFromFunc(func() (*testResource, error) {
+		return resource, nil
+	}), func(r *testResource) TaskEither[int] {
+		return NewTaskEither(func() *Future[int] {
+			return NewFuture(func(resolve func(int), reject func(error)) {
+				close(started)
+				<-release
+				close(stopped)
+			})
+		})
+	}, func(r *testResource, err error) error {
+		return r.release(err)
+	}).Run()
+
+	<-started
+	future.Cancel()
+
+	_, err := future.Collect()
+	is.ErrorIs(err, context.Canceled)
+	is.Equal(1, resource.released)
+	is.Equal(context.Canceled, resource.releaseErr)
+
+	close(release)
+	<-stopped
+}
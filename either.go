@@ -166,3 +166,33 @@ func (e Either[L, R]) MapRight(mapper func(R) Either[L, R]) Either[L, R] {
 
 	panic(eitherShouldBeLeftOrRight)
 }
+
+// MarshalJSON encodes Either into json, using DefaultEitherEncoding.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	return e.MarshalJSONWith(DefaultEitherEncoding)
+}
+
+// UnmarshalJSON decodes Either from json, using DefaultEitherEncoding.
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalJSONWith(data, DefaultEitherEncoding)
+}
+
+// MarshalJSONWith encodes Either into json, the arms being named "left" and "right".
+func (e Either[L, R]) MarshalJSONWith(encoding EitherEncoding) ([]byte, error) {
+	if e.isLeft {
+		return marshalEither(encoding, "left", e.left)
+	}
+	return marshalEither(encoding, "right", e.right)
+}
+
+// UnmarshalJSONWith decodes Either from json, the arms being named "left" and "right".
+func (e *Either[L, R]) UnmarshalJSONWith(data []byte, encoding EitherEncoding) error {
+	return unmarshalEither(encoding, data, []eitherArm{
+		newEitherArm("left", func(value L) {
+			*e = Left[L, R](value)
+		}),
+		newEitherArm("right", func(value R) {
+			*e = Right[L, R](value)
+		}),
+	})
+}
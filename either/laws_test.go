@@ -0,0 +1,49 @@
+package either
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/lawtest"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+func genInt(r *rand.Rand) int {
+	return r.Intn(200) - 100
+}
+
+func genEither(r *rand.Rand) Either[string, int] {
+	if r.Intn(4) == 0 {
+		return Left[string, int]("foo")
+	}
+	return Right[string, int](genInt(r))
+}
+
+func TestEitherLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Either[string, int], Either[string, func(int) int]]{
+		Instance: Monad[string, int, int](),
+		OfFunc:   Monad[string, func(int) int, func(int) int](),
+		GenA:     genInt,
+		GenFA:    genEither,
+		GenFunc: func(r *rand.Rand) func(int) int {
+			n := genInt(r)
+			return func(i int) int { return i*2 + n }
+		},
+		GenKleisli: func(r *rand.Rand) func(int) Either[string, int] {
+			n := genInt(r)
+			return func(i int) Either[string, int] {
+				if (i+n)%3 == 0 {
+					return Left[string, int]("bar")
+				}
+				return Right[string, int](i - n)
+			}
+		},
+		Equal: lawtest.Comparable[Either[string, int]](),
+	})
+
+	lawtest.Monoid(t, lawtest.MonoidLaws[Either[string, int]]{
+		Instance: Monoid[string, int](typeclass.SumMonoid[int]()),
+		Gen:      genEither,
+		Equal:    lawtest.Comparable[Either[string, int]](),
+	})
+}
@@ -0,0 +1,124 @@
+package either
+
+import "github.com/tperdue321/mo/typeclass"
+
+// Monad returns the Functor, Applicative and Monad instance of the Right side of Either.
+func Monad[L, A, B any]() typeclass.Monad[A, B, Either[L, A], Either[L, B], Either[L, func(A) B]] {
+	return eitherInstance[L, A, B]{}
+}
+
+// Foldable returns the Foldable instance of the Right side of Either. Left values are folded as empty.
+func Foldable[L, A, B any]() typeclass.Foldable[A, B, Either[L, A]] {
+	return eitherInstance[L, A, B]{}
+}
+
+// Filterable returns the Filterable instance of the Right side of Either. Right values not
+// matching the predicate are turned into the Left value built by onFalse.
+func Filterable[L, A any](onFalse func(A) L) typeclass.Filterable[A, Either[L, A]] {
+	return eitherFilterable[L, A]{onFalse: onFalse}
+}
+
+// Traversable returns the Traversable instance of the Right side of Either, running effects
+// of the applicative `G` described by pointed and functor.
+func Traversable[L, A, B, GB, GFB any](pointed typeclass.Pointed[Either[L, B], GFB], functor typeclass.Functor[B, Either[L, B], GB, GFB]) typeclass.Traversable[A, B, Either[L, A], GB, GFB] {
+	return eitherTraversable[L, A, B, GB, GFB]{
+		pointed: pointed,
+		functor: functor,
+	}
+}
+
+// Semigroup returns the Semigroup of Either, combining Right values with the given Semigroup.
+// The first Left wins.
+func Semigroup[L, R any](semigroup typeclass.Semigroup[R]) typeclass.Semigroup[Either[L, R]] {
+	return eitherSemigroup[L, R]{semigroup: semigroup}
+}
+
+// Monoid returns the Monoid of Either, combining Right values with the given Monoid.
+// The first Left wins and Right of the identity element is the identity element.
+func Monoid[L, R any](monoid typeclass.Monoid[R]) typeclass.Monoid[Either[L, R]] {
+	return eitherMonoid[L, R]{
+		eitherSemigroup: eitherSemigroup[L, R]{semigroup: monoid},
+		empty:           monoid.Empty(),
+	}
+}
+
+type eitherInstance[L, A, B any] struct{}
+
+func (eitherInstance[L, A, B]) Of(a A) Either[L, A] {
+	return Right[L, A](a)
+}
+
+func (eitherInstance[L, A, B]) Map(fa Either[L, A], f func(A) B) Either[L, B] {
+	return WrapEither[L, A, B](fa).Map(f)
+}
+
+func (eitherInstance[L, A, B]) Ap(fab Either[L, func(A) B], fa Either[L, A]) Either[L, B] {
+	if fab.IsLeft() {
+		return Left[L, B](fab.Left())
+	}
+	return WrapEither[L, A, B](fa).Map(fab.Right())
+}
+
+func (eitherInstance[L, A, B]) FlatMap(fa Either[L, A], f func(A) Either[L, B]) Either[L, B] {
+	return WrapEither[L, A, B](fa).FlatMap(f)
+}
+
+func (eitherInstance[L, A, B]) FoldLeft(fa Either[L, A], initial B, f func(B, A) B) B {
+	if fa.IsRight() {
+		return f(initial, fa.Right())
+	}
+	return initial
+}
+
+func (eitherInstance[L, A, B]) FoldRight(fa Either[L, A], initial B, f func(A, B) B) B {
+	if fa.IsRight() {
+		return f(fa.Right(), initial)
+	}
+	return initial
+}
+
+type eitherFilterable[L, A any] struct {
+	onFalse func(A) L
+}
+
+func (f eitherFilterable[L, A]) Filter(fa Either[L, A], predicate func(A) bool) Either[L, A] {
+	if fa.IsRight() && !predicate(fa.Right()) {
+		return Left[L, A](f.onFalse(fa.Right()))
+	}
+	return fa
+}
+
+type eitherTraversable[L, A, B, GB, GFB any] struct {
+	pointed typeclass.Pointed[Either[L, B], GFB]
+	functor typeclass.Functor[B, Either[L, B], GB, GFB]
+}
+
+func (t eitherTraversable[L, A, B, GB, GFB]) Traverse(fa Either[L, A], f func(A) GB) GFB {
+	if fa.IsLeft() {
+		return t.pointed.Of(Left[L, B](fa.Left()))
+	}
+	return t.functor.Map(f(fa.Right()), Right[L, B])
+}
+
+type eitherSemigroup[L, R any] struct {
+	semigroup typeclass.Semigroup[R]
+}
+
+func (s eitherSemigroup[L, R]) Concat(x Either[L, R], y Either[L, R]) Either[L, R] {
+	if x.IsLeft() {
+		return x
+	}
+	if y.IsLeft() {
+		return y
+	}
+	return Right[L, R](s.semigroup.Concat(x.Right(), y.Right()))
+}
+
+type eitherMonoid[L, R any] struct {
+	eitherSemigroup[L, R]
+	empty R
+}
+
+func (m eitherMonoid[L, R]) Empty() Either[L, R] {
+	return Right[L, R](m.empty)
+}
@@ -0,0 +1,100 @@
+package either
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo/option"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+func double[FA, FB any](functor typeclass.Functor[int, int, FA, FB], fa FA) FB {
+	return functor.Map(fa, func(i int) int {
+		return i * 2
+	})
+}
+
+func TestEitherMonad(t *testing.T) {
+	is := assert.New(t)
+
+	m := Monad[bool, int, string]()
+
+	is.Equal(Right[bool, int](42), m.Of(42))
+	is.Equal(Right[bool, string]("42"), m.Map(Right[bool, int](42), strconv.Itoa))
+	is.Equal(Left[bool, string](true), m.Map(Left[bool, int](true), strconv.Itoa))
+	is.Equal(Right[bool, string]("42"), m.Ap(Right[bool, func(int) string](strconv.Itoa), Right[bool, int](42)))
+	is.Equal(Left[bool, string](true), m.Ap(Left[bool, func(int) string](true), Right[bool, int](42)))
+	is.Equal(Left[bool, string](true), m.Ap(Right[bool, func(int) string](strconv.Itoa), Left[bool, int](true)))
+	is.Equal(Right[bool, string]("42"), m.FlatMap(Right[bool, int](42), func(i int) Either[bool, string] {
+		return Right[bool, string](strconv.Itoa(i))
+	}))
+	is.Equal(Left[bool, string](false), m.FlatMap(Right[bool, int](42), func(i int) Either[bool, string] {
+		return Left[bool, string](false)
+	}))
+
+	is.Equal(Right[bool, int](84), double[Either[bool, int], Either[bool, int]](Monad[bool, int, int](), Right[bool, int](42)))
+}
+
+func TestEitherFoldable(t *testing.T) {
+	is := assert.New(t)
+
+	f := Foldable[bool, int, string]()
+
+	is.Equal("foo42", f.FoldLeft(Right[bool, int](42), "foo", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	}))
+	is.Equal("42foo", f.FoldRight(Right[bool, int](42), "foo", func(i int, acc string) string {
+		return strconv.Itoa(i) + acc
+	}))
+	is.Equal("foo", f.FoldLeft(Left[bool, int](true), "foo", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	}))
+}
+
+func TestEitherFilterable(t *testing.T) {
+	is := assert.New(t)
+
+	f := Filterable(func(i int) string {
+		return strconv.Itoa(i) + " is odd"
+	})
+	isEven := func(i int) bool {
+		return i%2 == 0
+	}
+
+	is.Equal(Right[string, int](42), f.Filter(Right[string, int](42), isEven))
+	is.Equal(Left[string, int]("21 is odd"), f.Filter(Right[string, int](21), isEven))
+	is.Equal(Left[string, int]("foo"), f.Filter(Left[string, int]("foo"), isEven))
+}
+
+func TestEitherTraversable(t *testing.T) {
+	is := assert.New(t)
+
+	tr := Traversable[bool, string, int, option.Option[int], option.Option[Either[bool, int]]](
+		option.Monad[Either[bool, int], Either[bool, int]](),
+		option.Monad[int, Either[bool, int]](),
+	)
+	parse := func(s string) option.Option[int] {
+		if v, err := strconv.Atoi(s); err == nil {
+			return option.Some(v)
+		}
+		return option.None[int]()
+	}
+
+	is.Equal(option.Some(Right[bool, int](42)), tr.Traverse(Right[bool, string]("42"), parse))
+	is.Equal(option.None[Either[bool, int]](), tr.Traverse(Right[bool, string]("foo"), parse))
+	is.Equal(option.Some(Left[bool, int](true)), tr.Traverse(Left[bool, string](true), parse))
+}
+
+func TestEitherSemigroupAndMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	s := Semigroup[bool, int](typeclass.SumMonoid[int]())
+	m := Monoid[bool, int](typeclass.SumMonoid[int]())
+
+	is.Equal(Right[bool, int](63), s.Concat(Right[bool, int](21), Right[bool, int](42)))
+	is.Equal(Right[bool, int](0), m.Empty())
+	is.Equal(Right[bool, int](63), m.Concat(Right[bool, int](21), Right[bool, int](42)))
+	is.Equal(Left[bool, int](true), m.Concat(Left[bool, int](true), Right[bool, int](42)))
+	is.Equal(Left[bool, int](false), m.Concat(Right[bool, int](21), Left[bool, int](false)))
+}
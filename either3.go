@@ -221,3 +221,42 @@ func (e Either3[T1, T2, T3]) MapArg3(mapper func(T3) Either3[T1, T2, T3]) Either
 
 	return e
 }
+
+// MarshalJSON encodes Either3 into json, using DefaultEitherEncoding.
+func (e Either3[T1, T2, T3]) MarshalJSON() ([]byte, error) {
+	return e.MarshalJSONWith(DefaultEitherEncoding)
+}
+
+// UnmarshalJSON decodes Either3 from json, using DefaultEitherEncoding.
+func (e *Either3[T1, T2, T3]) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalJSONWith(data, DefaultEitherEncoding)
+}
+
+// MarshalJSONWith encodes Either3 into json, the arms being named "arg1", "arg2", "arg3".
+func (e Either3[T1, T2, T3]) MarshalJSONWith(encoding EitherEncoding) ([]byte, error) {
+	switch e.argId {
+	case either3ArgId1:
+		return marshalEither(encoding, "arg1", e.arg1)
+	case either3ArgId2:
+		return marshalEither(encoding, "arg2", e.arg2)
+	case either3ArgId3:
+		return marshalEither(encoding, "arg3", e.arg3)
+	}
+
+	return nil, either3InvalidArgumentId
+}
+
+// UnmarshalJSONWith decodes Either3 from json, the arms being named "arg1", "arg2", "arg3".
+func (e *Either3[T1, T2, T3]) UnmarshalJSONWith(data []byte, encoding EitherEncoding) error {
+	return unmarshalEither(encoding, data, []eitherArm{
+		newEitherArm("arg1", func(value T1) {
+			*e = NewEither3Arg1[T1, T2, T3](value)
+		}),
+		newEitherArm("arg2", func(value T2) {
+			*e = NewEither3Arg2[T1, T2, T3](value)
+		}),
+		newEitherArm("arg3", func(value T3) {
+			*e = NewEither3Arg3[T1, T2, T3](value)
+		}),
+	})
+}
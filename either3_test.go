@@ -1,6 +1,7 @@
 package mo
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -295,3 +296,39 @@ func TestEither3MapArg(t *testing.T) {
 	})
 	is.Equal(NewEither3Arg3[int, bool, float64](2.1), result3_3)
 }
+
+func TestEither3JSON(t *testing.T) {
+	is := assert.New(t)
+
+	either := NewEither3Arg3[int, string, bool](true)
+
+	value, err := json.Marshal(either)
+	is.NoError(err)
+	is.Equal(`{"type":"arg3","value":true}`, string(value))
+
+	var decoded Either3[int, string, bool]
+	is.NoError(json.Unmarshal(value, &decoded))
+	is.Equal(either, decoded)
+
+	value, err = either.MarshalJSONWith(EitherEncodingExternal)
+	is.NoError(err)
+	is.Equal(`{"arg3":true}`, string(value))
+	is.NoError(decoded.UnmarshalJSONWith(value, EitherEncodingExternal))
+	is.Equal(either, decoded)
+
+	value, err = either.MarshalJSONWith(EitherEncodingUntagged)
+	is.NoError(err)
+	is.Equal(`true`, string(value))
+	is.NoError(decoded.UnmarshalJSONWith(value, EitherEncodingUntagged))
+	is.Equal(either, decoded)
+
+	is.NoError(decoded.UnmarshalJSONWith([]byte(`42`), EitherEncodingUntagged))
+	is.Equal(NewEither3Arg1[int, string, bool](42), decoded)
+
+	err = decoded.UnmarshalJSONWith([]byte(`{}`), EitherEncodingUntagged)
+	is.ErrorContains(err, "either: no arm matches: arg1: json: cannot unmarshal object into Go value of type int")
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+
+	err = json.Unmarshal([]byte(`{"type":"arg4","value":42}`), &decoded)
+	is.ErrorContains(err, `unknown type "arg4"`)
+}
@@ -283,3 +283,47 @@ func (e Either4[T1, T2, T3, T4]) MapArg4(mapper func(T4) Either4[T1, T2, T3, T4]
 
 	return e
 }
+
+// MarshalJSON encodes Either4 into json, using DefaultEitherEncoding.
+func (e Either4[T1, T2, T3, T4]) MarshalJSON() ([]byte, error) {
+	return e.MarshalJSONWith(DefaultEitherEncoding)
+}
+
+// UnmarshalJSON decodes Either4 from json, using DefaultEitherEncoding.
+func (e *Either4[T1, T2, T3, T4]) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalJSONWith(data, DefaultEitherEncoding)
+}
+
+// MarshalJSONWith encodes Either4 into json, the arms being named "arg1", "arg2", "arg3", "arg4".
+func (e Either4[T1, T2, T3, T4]) MarshalJSONWith(encoding EitherEncoding) ([]byte, error) {
+	switch e.argId {
+	case either4ArgId1:
+		return marshalEither(encoding, "arg1", e.arg1)
+	case either4ArgId2:
+		return marshalEither(encoding, "arg2", e.arg2)
+	case either4ArgId3:
+		return marshalEither(encoding, "arg3", e.arg3)
+	case either4ArgId4:
+		return marshalEither(encoding, "arg4", e.arg4)
+	}
+
+	return nil, either4InvalidArgumentId
+}
+
+// UnmarshalJSONWith decodes Either4 from json, the arms being named "arg1", "arg2", "arg3", "arg4".
+func (e *Either4[T1, T2, T3, T4]) UnmarshalJSONWith(data []byte, encoding EitherEncoding) error {
+	return unmarshalEither(encoding, data, []eitherArm{
+		newEitherArm("arg1", func(value T1) {
+			*e = NewEither4Arg1[T1, T2, T3, T4](value)
+		}),
+		newEitherArm("arg2", func(value T2) {
+			*e = NewEither4Arg2[T1, T2, T3, T4](value)
+		}),
+		newEitherArm("arg3", func(value T3) {
+			*e = NewEither4Arg3[T1, T2, T3, T4](value)
+		}),
+		newEitherArm("arg4", func(value T4) {
+			*e = NewEither4Arg4[T1, T2, T3, T4](value)
+		}),
+	})
+}
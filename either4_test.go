@@ -1,6 +1,7 @@
 package mo
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -451,3 +452,39 @@ func TestEither4MapArg(t *testing.T) {
 	})
 	is.Equal(NewEither4Arg4[int, bool, float64, string]("Bye"), result4_4)
 }
+
+func TestEither4JSON(t *testing.T) {
+	is := assert.New(t)
+
+	either := NewEither4Arg4[int, string, bool, float64](1.5)
+
+	value, err := json.Marshal(either)
+	is.NoError(err)
+	is.Equal(`{"type":"arg4","value":1.5}`, string(value))
+
+	var decoded Either4[int, string, bool, float64]
+	is.NoError(json.Unmarshal(value, &decoded))
+	is.Equal(either, decoded)
+
+	value, err = either.MarshalJSONWith(EitherEncodingExternal)
+	is.NoError(err)
+	is.Equal(`{"arg4":1.5}`, string(value))
+	is.NoError(decoded.UnmarshalJSONWith(value, EitherEncodingExternal))
+	is.Equal(either, decoded)
+
+	value, err = either.MarshalJSONWith(EitherEncodingUntagged)
+	is.NoError(err)
+	is.Equal(`1.5`, string(value))
+	is.NoError(decoded.UnmarshalJSONWith(value, EitherEncodingUntagged))
+	is.Equal(either, decoded)
+
+	is.NoError(decoded.UnmarshalJSONWith([]byte(`42`), EitherEncodingUntagged))
+	is.Equal(NewEither4Arg1[int, string, bool, float64](42), decoded)
+
+	err = decoded.UnmarshalJSONWith([]byte(`{}`), EitherEncodingUntagged)
+	is.ErrorContains(err, "either: no arm matches: arg1: json: cannot unmarshal object into Go value of type int")
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+
+	err = json.Unmarshal([]byte(`{"type":"arg5","value":42}`), &decoded)
+	is.ErrorContains(err, `unknown type "arg5"`)
+}
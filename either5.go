@@ -345,3 +345,52 @@ func (e Either5[T1, T2, T3, T4, T5]) MapArg5(mapper func(T5) Either5[T1, T2, T3,
 
 	return e
 }
+
+// MarshalJSON encodes Either5 into json, using DefaultEitherEncoding.
+func (e Either5[T1, T2, T3, T4, T5]) MarshalJSON() ([]byte, error) {
+	return e.MarshalJSONWith(DefaultEitherEncoding)
+}
+
+// UnmarshalJSON decodes Either5 from json, using DefaultEitherEncoding.
+func (e *Either5[T1, T2, T3, T4, T5]) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalJSONWith(data, DefaultEitherEncoding)
+}
+
+// MarshalJSONWith encodes Either5 into json, the arms being named "arg1", "arg2", "arg3", "arg4", "arg5".
+func (e Either5[T1, T2, T3, T4, T5]) MarshalJSONWith(encoding EitherEncoding) ([]byte, error) {
+	switch e.argId {
+	case either5ArgId1:
+		return marshalEither(encoding, "arg1", e.arg1)
+	case either5ArgId2:
+		return marshalEither(encoding, "arg2", e.arg2)
+	case either5ArgId3:
+		return marshalEither(encoding, "arg3", e.arg3)
+	case either5ArgId4:
+		return marshalEither(encoding, "arg4", e.arg4)
+	case either5ArgId5:
+		return marshalEither(encoding, "arg5", e.arg5)
+	}
+
+	return nil, either5InvalidArgumentId
+}
+
+// UnmarshalJSONWith decodes Either5 from json, the arms being named "arg1", "arg2", "arg3", "arg4", "arg5".
+func (e *Either5[T1, T2, T3, T4, T5]) UnmarshalJSONWith(data []byte, encoding EitherEncoding) error {
+	return unmarshalEither(encoding, data, []eitherArm{
+		newEitherArm("arg1", func(value T1) {
+			*e = NewEither5Arg1[T1, T2, T3, T4, T5](value)
+		}),
+		newEitherArm("arg2", func(value T2) {
+			*e = NewEither5Arg2[T1, T2, T3, T4, T5](value)
+		}),
+		newEitherArm("arg3", func(value T3) {
+			*e = NewEither5Arg3[T1, T2, T3, T4, T5](value)
+		}),
+		newEitherArm("arg4", func(value T4) {
+			*e = NewEither5Arg4[T1, T2, T3, T4, T5](value)
+		}),
+		newEitherArm("arg5", func(value T5) {
+			*e = NewEither5Arg5[T1, T2, T3, T4, T5](value)
+		}),
+	})
+}
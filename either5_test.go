@@ -1,6 +1,7 @@
 package mo
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -645,3 +646,39 @@ func TestEither5MapArg(t *testing.T) {
 	})
 	is.Equal(NewEither5Arg5[int, bool, float64, string, byte](10), result5_5)
 }
+
+func TestEither5JSON(t *testing.T) {
+	is := assert.New(t)
+
+	either := NewEither5Arg5[int, string, bool, float64, []int]([]int{1, 2})
+
+	value, err := json.Marshal(either)
+	is.NoError(err)
+	is.Equal(`{"type":"arg5","value":[1,2]}`, string(value))
+
+	var decoded Either5[int, string, bool, float64, []int]
+	is.NoError(json.Unmarshal(value, &decoded))
+	is.Equal(either, decoded)
+
+	value, err = either.MarshalJSONWith(EitherEncodingExternal)
+	is.NoError(err)
+	is.Equal(`{"arg5":[1,2]}`, string(value))
+	is.NoError(decoded.UnmarshalJSONWith(value, EitherEncodingExternal))
+	is.Equal(either, decoded)
+
+	value, err = either.MarshalJSONWith(EitherEncodingUntagged)
+	is.NoError(err)
+	is.Equal(`[1,2]`, string(value))
+	is.NoError(decoded.UnmarshalJSONWith(value, EitherEncodingUntagged))
+	is.Equal(either, decoded)
+
+	is.NoError(decoded.UnmarshalJSONWith([]byte(`42`), EitherEncodingUntagged))
+	is.Equal(NewEither5Arg1[int, string, bool, float64, []int](42), decoded)
+
+	err = decoded.UnmarshalJSONWith([]byte(`{}`), EitherEncodingUntagged)
+	is.ErrorContains(err, "either: no arm matches: arg1: json: cannot unmarshal object into Go value of type int")
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+
+	err = json.Unmarshal([]byte(`{"type":"arg6","value":42}`), &decoded)
+	is.ErrorContains(err, `unknown type "arg6"`)
+}
@@ -0,0 +1,143 @@
+package mo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EitherEncoding selects how Either, Either3, Either4 and Either5 are encoded into json.
+type EitherEncoding int8
+
+const (
+	// EitherEncodingTagged encodes the value next to a discriminator field, such as
+	// `{"type":"left","value":42}`.
+	EitherEncodingTagged EitherEncoding = iota
+	// EitherEncodingExternal encodes the value under a key naming its arm, such as `{"left":42}`.
+	EitherEncodingExternal
+	// EitherEncodingUntagged encodes the value alone, such as `42`. Decoding tries every arm
+	// in order, rejecting unknown object fields, and keeps the first one matching. `null`
+	// matches no arm, since it cannot tell them apart: values encoded as null, such as nil
+	// pointers, do not round-trip.
+	EitherEncodingUntagged
+)
+
+// DefaultEitherEncoding is the encoding used by MarshalJSON and UnmarshalJSON. It is
+// read without synchronization: set it during program initialization only, and use
+// MarshalJSONWith and UnmarshalJSONWith to pick an encoding per call.
+var DefaultEitherEncoding = EitherEncodingTagged
+
+var (
+	// ErrEitherUnknownEncoding is returned when encoding or decoding with an EitherEncoding
+	// that is not one of the constants.
+	ErrEitherUnknownEncoding = errors.New("unknown either encoding")
+	// ErrEitherNoArmMatches is returned when json data cannot be decoded into any arm of an Either.
+	ErrEitherNoArmMatches = errors.New("either: no arm matches")
+)
+
+// eitherArm describes how to decode one of the possible types of an Either.
+type eitherArm struct {
+	tag    string
+	decode func(data []byte, strict bool) error
+}
+
+// newEitherArm returns an eitherArm decoding a T and passing it to set.
+func newEitherArm[T any](tag string, set func(T)) eitherArm {
+	return eitherArm{
+		tag: tag,
+		decode: func(data []byte, strict bool) error {
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			if strict {
+				decoder.DisallowUnknownFields()
+			}
+
+			var value T
+			if err := decoder.Decode(&value); err != nil {
+				return err
+			}
+
+			set(value)
+			return nil
+		},
+	}
+}
+
+type taggedEither struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// marshalEither encodes the value of the arm named tag.
+func marshalEither(encoding EitherEncoding, tag string, value any) ([]byte, error) {
+	switch encoding {
+	case EitherEncodingTagged:
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(taggedEither{Type: tag, Value: raw})
+	case EitherEncodingExternal:
+		return json.Marshal(map[string]any{tag: value})
+	case EitherEncodingUntagged:
+		return json.Marshal(value)
+	}
+
+	return nil, fmt.Errorf("%w: %d", ErrEitherUnknownEncoding, encoding)
+}
+
+// unmarshalEither decodes data into the matching arm.
+func unmarshalEither(encoding EitherEncoding, data []byte, arms []eitherArm) error {
+	switch encoding {
+	case EitherEncodingTagged:
+		var tagged taggedEither
+		if err := json.Unmarshal(data, &tagged); err != nil {
+			return err
+		}
+		if tagged.Value == nil {
+			return fmt.Errorf("%w: missing \"value\" field", ErrEitherNoArmMatches)
+		}
+		return decodeEitherArm(arms, tagged.Type, tagged.Value)
+	case EitherEncodingExternal:
+		var external map[string]json.RawMessage
+		if err := json.Unmarshal(data, &external); err != nil {
+			return err
+		}
+		if len(external) != 1 {
+			return fmt.Errorf("%w: expected a single key, got %d", ErrEitherNoArmMatches, len(external))
+		}
+		for tag, raw := range external {
+			return decodeEitherArm(arms, tag, raw)
+		}
+	case EitherEncodingUntagged:
+		if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+			return fmt.Errorf("%w: null is ambiguous", ErrEitherNoArmMatches)
+		}
+
+		messages := make([]string, 0, len(arms))
+		for _, arm := range arms {
+			err := arm.decode(data, true)
+			if err == nil {
+				return nil
+			}
+			messages = append(messages, arm.tag+": "+err.Error())
+		}
+		return fmt.Errorf("%w: %s", ErrEitherNoArmMatches, strings.Join(messages, "; "))
+	}
+
+	return fmt.Errorf("%w: %d", ErrEitherUnknownEncoding, encoding)
+}
+
+// decodeEitherArm decodes data into the arm named tag.
+func decodeEitherArm(arms []eitherArm, tag string, data []byte) error {
+	tags := make([]string, 0, len(arms))
+	for _, arm := range arms {
+		if arm.tag == tag {
+			return arm.decode(data, false)
+		}
+		tags = append(tags, fmt.Sprintf("%q", arm.tag))
+	}
+
+	return fmt.Errorf("%w: unknown type %q, expected one of %s", ErrEitherNoArmMatches, tag, strings.Join(tags, ", "))
+}
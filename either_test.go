@@ -1,6 +1,7 @@
 package mo
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -211,3 +212,116 @@ func TestEitherMapRight(t *testing.T) {
 	is.Equal(Either[int, string]{left: 42, right: "", isLeft: true}, e1)
 	is.Equal(Either[int, string]{left: 0, right: "plop", isLeft: false}, e2)
 }
+
+type testCircle struct {
+	Radius int `json:"radius"`
+}
+
+type testSquare struct {
+	Side int `json:"side"`
+}
+
+func TestEitherMarshalJSON(t *testing.T) {
+	is := assert.New(t)
+
+	left := Left[testCircle, testSquare](testCircle{Radius: 1})
+	right := Right[testCircle, testSquare](testSquare{Side: 2})
+
+	value, err := json.Marshal(left)
+	is.NoError(err)
+	is.Equal(`{"type":"left","value":{"radius":1}}`, string(value))
+
+	value, err = json.Marshal(right)
+	is.NoError(err)
+	is.Equal(`{"type":"right","value":{"side":2}}`, string(value))
+
+	value, err = left.MarshalJSONWith(EitherEncodingExternal)
+	is.NoError(err)
+	is.Equal(`{"left":{"radius":1}}`, string(value))
+
+	value, err = right.MarshalJSONWith(EitherEncodingUntagged)
+	is.NoError(err)
+	is.Equal(`{"side":2}`, string(value))
+
+	_, err = right.MarshalJSONWith(EitherEncoding(42))
+	is.EqualError(err, "unknown either encoding: 42")
+	is.ErrorIs(err, ErrEitherUnknownEncoding)
+
+	type testStruct struct {
+		Field Either[int, string] `json:"field"`
+	}
+	value, err = json.Marshal(testStruct{Field: Right[int]("foo")})
+	is.NoError(err)
+	is.Equal(`{"field":{"type":"right","value":"foo"}}`, string(value))
+}
+
+func TestEitherUnmarshalJSON(t *testing.T) {
+	is := assert.New(t)
+
+	var shape Either[testCircle, testSquare]
+
+	err := json.Unmarshal([]byte(`{"type":"right","value":{"side":2}}`), &shape)
+	is.NoError(err)
+	is.Equal(Right[testCircle](testSquare{Side: 2}), shape)
+
+	err = json.Unmarshal([]byte(`{"type":"left","value":{"radius":1}}`), &shape)
+	is.NoError(err)
+	is.Equal(Left[testCircle, testSquare](testCircle{Radius: 1}), shape)
+
+	err = json.Unmarshal([]byte(`{"type":"triangle","value":{}}`), &shape)
+	is.EqualError(err, `either: no arm matches: unknown type "triangle", expected one of "left", "right"`)
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+
+	err = json.Unmarshal([]byte(`{"type":"left"}`), &shape)
+	is.EqualError(err, `either: no arm matches: missing "value" field`)
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+
+	err = shape.UnmarshalJSONWith([]byte(`{"right":{"side":3}}`), EitherEncodingExternal)
+	is.NoError(err)
+	is.Equal(Right[testCircle](testSquare{Side: 3}), shape)
+
+	err = shape.UnmarshalJSONWith([]byte(`{"left":{},"right":{}}`), EitherEncodingExternal)
+	is.EqualError(err, "either: no arm matches: expected a single key, got 2")
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+
+	err = shape.UnmarshalJSONWith([]byte(`{"radius":4}`), EitherEncodingUntagged)
+	is.NoError(err)
+	is.Equal(Left[testCircle, testSquare](testCircle{Radius: 4}), shape)
+
+	err = shape.UnmarshalJSONWith([]byte(`{"side":5}`), EitherEncodingUntagged)
+	is.NoError(err)
+	is.Equal(Right[testCircle](testSquare{Side: 5}), shape)
+
+	err = shape.UnmarshalJSONWith([]byte(`{"angle":6}`), EitherEncodingUntagged)
+	is.EqualError(err, `either: no arm matches: left: json: unknown field "angle"; right: json: unknown field "angle"`)
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+
+	var number Either[int, string]
+	err = number.UnmarshalJSONWith([]byte(`"foo"`), EitherEncodingUntagged)
+	is.NoError(err)
+	is.Equal(Right[int]("foo"), number)
+
+	err = number.UnmarshalJSONWith([]byte(` null `), EitherEncodingUntagged)
+	is.EqualError(err, "either: no arm matches: null is ambiguous")
+	is.ErrorIs(err, ErrEitherNoArmMatches)
+	is.Equal(Right[int]("foo"), number)
+
+	err = number.UnmarshalJSONWith([]byte(`42`), EitherEncoding(42))
+	is.EqualError(err, "unknown either encoding: 42")
+	is.ErrorIs(err, ErrEitherUnknownEncoding)
+}
+
+func TestEitherJSONRoundTrip(t *testing.T) {
+	is := assert.New(t)
+
+	for _, encoding := range []EitherEncoding{EitherEncodingTagged, EitherEncodingExternal, EitherEncodingUntagged} {
+		for _, either := range []Either[int, string]{Left[int, string](42), Right[int]("foo")} {
+			value, err := either.MarshalJSONWith(encoding)
+			is.NoError(err)
+
+			var decoded Either[int, string]
+			is.NoError(decoded.UnmarshalJSONWith(value, encoding))
+			is.Equal(either, decoded)
+		}
+	}
+}
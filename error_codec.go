@@ -0,0 +1,130 @@
+package mo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrorCodec encodes the error of a Result into json and decodes it back when Result
+// is marshaled.
+type ErrorCodec interface {
+	EncodeError(err error) ([]byte, error)
+	DecodeError(data []byte) (error, error)
+}
+
+// DefaultErrorCodec is the codec used by the marshaling methods of Result. It is read
+// without synchronization: set it during program initialization only, and use
+// MarshalJSONWith and UnmarshalJSONWith to pick a codec per call.
+var DefaultErrorCodec ErrorCodec = MessageErrorCodec{}
+
+// MessageErrorCodec encodes errors as their message. Decoded errors are created with
+// errors.New, losing their type. A nil error is encoded as null.
+type MessageErrorCodec struct{}
+
+// EncodeError implements the ErrorCodec interface.
+func (MessageErrorCodec) EncodeError(err error) ([]byte, error) {
+	if err == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(err.Error())
+}
+
+// DecodeError implements the ErrorCodec interface.
+func (MessageErrorCodec) DecodeError(data []byte) (error, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+
+	var message string
+	if err := json.Unmarshal(data, &message); err != nil {
+		return nil, err
+	}
+	return errors.New(message), nil
+}
+
+// NewTypedErrorCodec returns an empty TypedErrorCodec. Register the error types with
+// RegisterError.
+func NewTypedErrorCodec() *TypedErrorCodec {
+	return &TypedErrorCodec{
+		decoders: map[string]func([]byte) (error, error){},
+	}
+}
+
+// TypedErrorCodec encodes registered error types as `{"type":"name","value":...}`, so that
+// they are decoded into the same type. Other errors are encoded as their message, like
+// MessageErrorCodec does.
+type TypedErrorCodec struct {
+	encoders []typedErrorEncoder
+	decoders map[string]func([]byte) (error, error)
+}
+
+// typedErrorEncoder matches the errors of the type registered under name.
+type typedErrorEncoder struct {
+	name string
+	// as returns the first error of the chain of err holding the registered type.
+	as func(err error) (error, bool)
+}
+
+// RegisterError registers the error type E under name. E is encoded with json, so its
+// fields should be exported. Register the errors before using the codec: registration
+// is not safe for concurrent use. Errors wrapping an E are matched with errors.As, and encoded
+// as that E: the wrapping errors are lost.
+func RegisterError[E error](codec *TypedErrorCodec, name string) {
+	codec.encoders = append(codec.encoders, typedErrorEncoder{
+		name: name,
+		as: func(err error) (error, bool) {
+			var target E
+			if errors.As(err, &target) {
+				return target, true
+			}
+			return nil, false
+		},
+	})
+	codec.decoders[name] = func(data []byte) (error, error) {
+		var err E
+		if decodeErr := json.Unmarshal(data, &err); decodeErr != nil {
+			return nil, decodeErr
+		}
+		return err, nil
+	}
+}
+
+type typedErrorPayload struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// EncodeError implements the ErrorCodec interface.
+func (c *TypedErrorCodec) EncodeError(err error) ([]byte, error) {
+	for _, encoder := range c.encoders {
+		if matched, ok := encoder.as(err); ok {
+			value, encodeErr := json.Marshal(matched)
+			if encodeErr != nil {
+				return nil, encodeErr
+			}
+			return json.Marshal(typedErrorPayload{Type: encoder.name, Value: value})
+		}
+	}
+
+	return MessageErrorCodec{}.EncodeError(err)
+}
+
+// DecodeError implements the ErrorCodec interface.
+func (c *TypedErrorCodec) DecodeError(data []byte) (error, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return MessageErrorCodec{}.DecodeError(data)
+	}
+
+	var payload typedErrorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	decoder, ok := c.decoders[payload.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown error type %q", payload.Type)
+	}
+	return decoder(payload.Value)
+}
@@ -0,0 +1,113 @@
+package mo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testQuotaError is a typed error surviving a round-trip through TypedErrorCodec.
+type testQuotaError struct {
+	Limit int `json:"limit"`
+}
+
+func (e *testQuotaError) Error() string {
+	return "quota exceeded"
+}
+
+func TestMessageErrorCodec(t *testing.T) {
+	is := assert.New(t)
+
+	codec := MessageErrorCodec{}
+
+	data, err := codec.EncodeError(assert.AnError)
+	is.NoError(err)
+	is.Equal(`"assert.AnError general error for testing"`, string(data))
+
+	decoded, err := codec.DecodeError(data)
+	is.NoError(err)
+	is.EqualError(decoded, assert.AnError.Error())
+
+	_, err = codec.DecodeError([]byte(`42`))
+	is.Error(err)
+
+	data, err = codec.EncodeError(nil)
+	is.NoError(err)
+	is.Equal(`null`, string(data))
+
+	decoded, err = codec.DecodeError(data)
+	is.NoError(err)
+	is.Nil(decoded)
+}
+
+func TestTypedErrorCodec(t *testing.T) {
+	is := assert.New(t)
+
+	codec := NewTypedErrorCodec()
+	RegisterError[*testQuotaError](codec, "quota")
+
+	data, err := codec.EncodeError(&testQuotaError{Limit: 10})
+	is.NoError(err)
+	is.Equal(`{"type":"quota","value":{"limit":10}}`, string(data))
+
+	decoded, err := codec.DecodeError(data)
+	is.NoError(err)
+	is.Equal(&testQuotaError{Limit: 10}, decoded)
+
+	// wrapped errors are encoded as the registered error they wrap
+	data, err = codec.EncodeError(fmt.Errorf("request failed: %w", &testQuotaError{Limit: 20}))
+	is.NoError(err)
+	is.Equal(`{"type":"quota","value":{"limit":20}}`, string(data))
+
+	data, err = codec.EncodeError(nil)
+	is.NoError(err)
+	is.Equal(`null`, string(data))
+
+	data, err = codec.EncodeError(assert.AnError)
+	is.NoError(err)
+	is.Equal(`"assert.AnError general error for testing"`, string(data))
+
+	decoded, err = codec.DecodeError(data)
+	is.NoError(err)
+	is.EqualError(decoded, assert.AnError.Error())
+
+	_, err = codec.DecodeError([]byte(`{"type":"unknown","value":{}}`))
+	is.EqualError(err, `unknown error type "unknown"`)
+}
+
+func TestResultWithTypedErrorCodec(t *testing.T) {
+	is := assert.New(t)
+
+	codec := NewTypedErrorCodec()
+	RegisterError[*testQuotaError](codec, "quota")
+
+	data, err := Err[int](&testQuotaError{Limit: 10}).MarshalJSONWith(codec)
+	is.NoError(err)
+	is.Equal(`{"error":{"type":"quota","value":{"limit":10}}}`, string(data))
+
+	var result Result[int]
+	is.NoError(result.UnmarshalJSONWith(data, codec))
+
+	var quotaErr *testQuotaError
+	is.True(errors.As(result.Error(), &quotaErr))
+	is.Equal(10, quotaErr.Limit)
+
+	previous := DefaultErrorCodec
+	DefaultErrorCodec = codec
+	defer func() {
+		DefaultErrorCodec = previous
+	}()
+
+	data, err = json.Marshal(Err[int](&testQuotaError{Limit: 20}))
+	is.NoError(err)
+	is.NoError(json.Unmarshal(data, &result))
+	is.Equal(&testQuotaError{Limit: 20}, result.Error())
+
+	data, err = Err[int](&testQuotaError{Limit: 30}).MarshalBinary()
+	is.NoError(err)
+	is.NoError(result.UnmarshalBinary(data))
+	is.Equal(&testQuotaError{Limit: 30}, result.Error())
+}
@@ -0,0 +1,99 @@
+package mo
+
+import "sync"
+
+// Executor runs the callbacks of Futures and Tasks.
+type Executor interface {
+	// Execute runs fn asynchronously.
+	Execute(fn func())
+}
+
+// WithExecutor runs the callback of a Future, or the whole run of a Task, on executor
+// instead of a new goroutine. A Task run on an executor holds its worker until the
+// Future it returns is settled, which bounds the number of Tasks in flight.
+func WithExecutor(executor Executor) RunOption {
+	return func(options *runOptions) {
+		options.executor = executor
+	}
+}
+
+// NewWorkerPool starts an Executor running callbacks on a fixed number of workers.
+// Up to queueSize callbacks wait for a free worker: once the queue is full, Execute
+// blocks until a worker takes a callback. Callbacks must not wait for Futures run on
+// the same pool, or it may deadlock once every worker is busy.
+func NewWorkerPool(workers int, queueSize int) *WorkerPool {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	pool := &WorkerPool{
+		queue: make(chan func(), queueSize),
+	}
+
+	pool.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go pool.work()
+	}
+
+	return pool
+}
+
+// WorkerPool is an Executor with bounded concurrency. See NewWorkerPool.
+type WorkerPool struct {
+	mu     sync.RWMutex
+	closed bool
+	queue  chan func()
+	wg     sync.WaitGroup
+}
+
+func (p *WorkerPool) work() {
+	defer p.wg.Done()
+
+	for fn := range p.queue {
+		fn()
+	}
+}
+
+// Execute queues fn, blocking while the queue is full. It panics when the pool is closed.
+func (p *WorkerPool) Execute(fn func()) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		panic("mo: Execute called on a closed WorkerPool")
+	}
+	p.queue <- fn
+}
+
+// TryExecute queues fn without blocking. It returns false when the queue is full or
+// the pool is closed.
+func (p *WorkerPool) TryExecute(fn func()) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return false
+	}
+
+	select {
+	case p.queue <- fn:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops accepting callbacks and waits for the queued ones to complete.
+func (p *WorkerPool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
@@ -0,0 +1,125 @@
+package mo
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrencyTracker records the maximum number of concurrent calls between enter and leave.
+type concurrencyTracker struct {
+	current int32
+	max     int32
+}
+
+func (c *concurrencyTracker) enter() {
+	current := atomic.AddInt32(&c.current, 1)
+	for {
+		max := atomic.LoadInt32(&c.max)
+		if current <= max || atomic.CompareAndSwapInt32(&c.max, max, current) {
+			return
+		}
+	}
+}
+
+func (c *concurrencyTracker) leave() {
+	atomic.AddInt32(&c.current, -1)
+}
+
+func TestWorkerPool(t *testing.T) {
+	is := assert.New(t)
+
+	pool := NewWorkerPool(2, 10)
+	tracker := &concurrencyTracker{}
+
+	var wg sync.WaitGroup
+	wg.Add(10)
+	for i := 0; i < 10; i++ {
+		pool.Execute(func() {
+			defer wg.Done()
+			tracker.enter()
+			time.Sleep(time.Millisecond)
+			tracker.leave()
+		})
+	}
+	wg.Wait()
+	pool.Close()
+
+	is.Equal(int32(2), atomic.LoadInt32(&tracker.max))
+	is.False(pool.TryExecute(func() {}))
+	is.Panics(func() {
+		pool.Execute(func() {})
+	})
+}
+
+func TestWorkerPoolQueueLimit(t *testing.T) {
+	is := assert.New(t)
+
+	pool := NewWorkerPool(1, 1)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	is.True(pool.TryExecute(func() {
+		close(started)
+		<-release
+	}))
+	<-started
+	is.True(pool.TryExecute(func() {}))
+	is.False(pool.TryExecute(func() {}))
+
+	close(release)
+	pool.Close()
+}
+
+func TestFutureWithExecutor(t *testing.T) {
+	is := assert.New(t)
+
+	pool := NewWorkerPool(1, 0)
+	defer pool.Close()
+
+	value, err := NewFuture(func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}, WithExecutor(pool)).Then(func(value int) (int, error) {
+		return value * 2, nil
+	}).Collect()
+
+	is.NoError(err)
+	is.Equal(84, value)
+}
+
+func TestTaskWithExecutor(t *testing.T) {
+	is := assert.New(t)
+
+	pool := NewWorkerPool(3, 100)
+	defer pool.Close()
+
+	tracker := &concurrencyTracker{}
+	task := NewTask1(func(a int) *Future[int] {
+		return NewFuture(func(resolve func(int), reject func(error)) {
+			tracker.enter()
+			time.Sleep(time.Millisecond)
+			tracker.leave()
+			resolve(a * 2)
+		})
+	}, WithExecutor(pool))
+
+	futures := make([]*Future[int], 0, 20)
+	for i := 0; i < 20; i++ {
+		futures = append(futures, task.Run(i))
+	}
+	values, err := All(futures...).Collect()
+
+	is.NoError(err)
+	is.Len(values, 20)
+	is.Equal(38, values[19])
+	is.Equal(int32(3), atomic.LoadInt32(&tracker.max))
+
+	value, err := NewTaskFromIO(NewIO(func() int {
+		return 42
+	}), WithExecutor(pool)).Run().Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+}
@@ -1,15 +1,59 @@
 package mo
 
 import (
+	"context"
+	"errors"
 	"sync"
+	"time"
 )
 
-// NewFuture instanciate a new future.
-func NewFuture[T any](cb func(resolve func(T), reject func(error))) *Future[T] {
+// ErrFutureTimeout is returned by the timeout variants of Collect when the Future
+// is not settled in time.
+var ErrFutureTimeout = errors.New("future timed out")
+
+// NewFuture instanciate a new future. Panics of the callback reject the Future with
+// a *PanicError, unless disabled with WithPanicRecovery.
+func NewFuture[T any](cb func(resolve func(T), reject func(error)), opts ...RunOption) *Future[T] {
+	return newFutureWithOptions(cb, newRunOptions(opts))
+}
+
+func newFutureWithOptions[T any](cb func(resolve func(T), reject func(error)), options runOptions) *Future[T] {
+	return newFutureWithCancel(cb, options, nil)
+}
+
+// newFutureWithCancel instanciates a new future calling onCancel when cancelled, instead
+// of being rejected: cb is then responsible for settling the Future. onCancel may be nil.
+func newFutureWithCancel[T any](cb func(resolve func(T), reject func(error)), options runOptions, onCancel func()) *Future[T] {
 	future := Future[T]{
 		cb:       cb,
+		cancelCb: func() {},
+		onCancel: onCancel,
+		done:     make(chan struct{}),
+		options:  options,
+	}
+
+	future.active()
+
+	return &future
+}
+
+// NewFutureWithContext instanciate a new future bound to a context. The callback receives
+// a context that is cancelled when the Future is cancelled, so that it can stop its work
+// cooperatively. The Future is rejected with `ctx.Err()` as soon as the context is done,
+// and Futures chained with Then, Catch or Finally inherit the context.
+func NewFutureWithContext[T any](ctx context.Context, cb func(ctx context.Context, resolve func(T), reject func(error)), opts ...RunOption) *Future[T] {
+	return newFutureWithContext(ctx, cb, newRunOptions(opts))
+}
+
+func newFutureWithContext[T any](ctx context.Context, cb func(ctx context.Context, resolve func(T), reject func(error)), options runOptions) *Future[T] {
+	future := Future[T]{
 		cancelCb: func() {},
 		done:     make(chan struct{}),
+		options:  options,
+	}
+	future.bind(ctx)
+	future.cb = func(resolve func(T), reject func(error)) {
+		cb(future.ctx, resolve, reject)
 	}
 
 	future.active()
@@ -17,6 +61,13 @@ func NewFuture[T any](cb func(resolve func(T), reject func(error))) *Future[T] {
 	return &future
 }
 
+// newRejectedFuture returns a Future already rejected with err.
+func newRejectedFuture[T any](err error) *Future[T] {
+	return NewFuture(func(resolve func(T), reject func(error)) {
+		reject(err)
+	})
+}
+
 // Future represents a value which may or may not currently be available, but will be
 // available at some point, or an exception if that value could not be made available.
 type Future[T any] struct {
@@ -24,139 +75,331 @@ type Future[T any] struct {
 
 	cb       func(func(T), func(error))
 	cancelCb func()
-	next     *Future[T]
-	done     chan struct{}
-	result   Result[T]
+	// onCancel, when set, is called by Cancel instead of rejecting the Future, for
+	// the Futures settling themselves once cancelled.
+	onCancel    func()
+	subscribers []*futureSubscriber
+	completed   bool
+	// cancelled is true when the Future was settled by Cancel.
+	cancelled bool
+	done      chan struct{}
+	result    Result[T]
+	options   runOptions
+
+	// parentCtx is the context chained Futures derive their own context from.
+	// It is nil for Futures created without context.
+	parentCtx context.Context
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+}
+
+// futureSubscriber is a continuation run once a Future is settled. It is
+// compared by pointer to be unsubscribed.
+type futureSubscriber struct {
+	run func()
+}
+
+// safeRun runs the subscriber, returning the value it panicked with, if any.
+func (s *futureSubscriber) safeRun() (panicked any) {
+	defer func() {
+		panicked = recover()
+	}()
+	s.run()
+	return nil
+}
+
+// bind derives the context of the Future from parent and rejects the Future
+// when that context is done before the Future settles. The context is released
+// once the Future is settled, so chained Futures derive from parent instead.
+func (f *Future[T]) bind(parent context.Context) {
+	f.parentCtx = parent
+	f.ctx, f.cancelCtx = context.WithCancel(parent)
+
+	go func() {
+		select {
+		case <-f.ctx.Done():
+			f.reject(f.ctx.Err())
+		case <-f.done:
+		}
+		f.cancelCtx()
+	}()
+}
+
+func (f *Future[T]) settled() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
 }
 
 func (f *Future[T]) active() {
-	go f.cb(f.resolve, f.reject)
+	if f.options.executor != nil {
+		f.options.executor.Execute(f.activeSync)
+		return
+	}
+	go f.activeSync()
 }
 
 func (f *Future[T]) activeSync() {
+	if f.options.recoverPanics {
+		defer recoverPanic(f.reject)
+	}
 	f.cb(f.resolve, f.reject)
 }
 
 func (f *Future[T]) resolve(value T) {
+	f.settle(Ok(value), false)
+}
+
+func (f *Future[T]) reject(err error) {
+	f.settle(Err[T](err), false)
+}
+
+// isCancelled returns true when the Future was settled by Cancel.
+func (f *Future[T]) isCancelled() bool {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.result = Ok(value)
-	if f.next != nil {
-		f.next.activeSync()
+	return f.cancelled
+}
+
+// settle stores the result, releases the Collect calls and runs the subscribers in
+// subscription order.
+func (f *Future[T]) settle(result Result[T], cancelled bool) {
+	f.mu.Lock()
+
+	// the first settlement wins: a context may be done while the callback settles.
+	if f.completed {
+		f.mu.Unlock()
+		return
 	}
+
+	f.result = result
+	f.completed = true
+	f.cancelled = cancelled
+	subscribers := f.subscribers
+	f.subscribers = nil
+	f.mu.Unlock()
+
+	// the result is published before the subscribers run, so that they can Collect
+	// the Future without deadlocking.
 	close(f.done)
+
+	f.runSubscribers(subscribers)
 }
 
-func (f *Future[T]) reject(err error) {
+// runSubscribers runs subscribers in order. A panicking subscriber does not prevent the
+// next ones from running. Panics are discarded when recovered, otherwise the first one is
+// raised again once every subscriber ran.
+func (f *Future[T]) runSubscribers(subscribers []*futureSubscriber) {
+	var panicked any
+	for _, subscriber := range subscribers {
+		if r := subscriber.safeRun(); r != nil && panicked == nil {
+			panicked = r
+		}
+	}
+	if panicked != nil && !f.options.recoverPanics {
+		panic(panicked)
+	}
+}
+
+// subscribe registers subscriber. It returns false when the Future is already
+// settled, in which case subscriber is not registered.
+func (f *Future[T]) subscribe(subscriber *futureSubscriber) bool {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.result = Err[T](err)
-	if f.next != nil {
-		f.next.activeSync()
+	if f.completed {
+		return false
 	}
-	close(f.done)
+	f.subscribers = append(f.subscribers, subscriber)
+	return true
 }
 
-// Then is called when Future is resolved. It returns a new Future.
-func (f *Future[T]) Then(cb func(T) (T, error)) *Future[T] {
+// unsubscribe removes subscriber. It returns true when it was the last one.
+func (f *Future[T]) unsubscribe(subscriber *futureSubscriber) bool {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.next = &Future[T]{
-		cb: func(resolve func(T), reject func(error)) {
-			if f.result.IsError() {
-				reject(f.result.Error())
-				return
-			}
-			newValue, err := cb(f.result.MustGet())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
-			f.Cancel()
-		},
-		done: make(chan struct{}),
+	for i, s := range f.subscribers {
+		if s == subscriber {
+			f.subscribers = append(f.subscribers[:i:i], f.subscribers[i+1:]...)
+			return len(f.subscribers) == 0
+		}
 	}
+	return false
+}
 
-	select {
-	case <-f.done:
-		f.next.active()
-	default:
-	}
-	return f.next
+// Then is called when Future is resolved. It returns a new Future.
+func (f *Future[T]) Then(cb func(T) (T, error)) *Future[T] {
+	return thenFuture(f, cb)
 }
 
 // Catch is called when Future is rejected. It returns a new Future.
 func (f *Future[T]) Catch(cb func(error) (T, error)) *Future[T] {
-	f.mu.Lock()
-	defer f.mu.Unlock()
+	return chain(f, func(resolve func(T), reject func(error)) {
+		if f.result.IsOk() {
+			resolve(f.result.MustGet())
+			return
+		}
+		newValue, err := cb(f.result.Error())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
+}
 
-	f.next = &Future[T]{
-		cb: func(resolve func(T), reject func(error)) {
-			if f.result.IsOk() {
-				resolve(f.result.MustGet())
-				return
-			}
-			newValue, err := cb(f.result.Error())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
+// Finally is called when Future is processed either resolved or rejected. It returns a new Future.
+func (f *Future[T]) Finally(cb func(T, error) (T, error)) *Future[T] {
+	return chain(f, func(resolve func(T), reject func(error)) {
+		newValue, err := cb(f.result.Get())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
+}
+
+// chain subscribes the Future running cb once f is settled. The new Future may hold
+// another type than f. When f is bound to a context, the new Future derives its context
+// from the same parent and skips cb if it is done. When f is cancelled, the new Future is
+// cancelled too instead of running cb. Cancelling the new Future unsubscribes it, and
+// cancels f when it was its last subscriber.
+func chain[T any, U any](f *Future[T], cb func(resolve func(U), reject func(error))) *Future[U] {
+	next := &Future[U]{
+		done:    make(chan struct{}),
+		options: f.options,
+	}
+	next.cb = func(resolve func(U), reject func(error)) {
+		// a cancelled Future may still be run by a settlement of f racing with Cancel.
+		if next.settled() {
+			return
+		}
+		if f.isCancelled() {
+			next.settle(Err[U](context.Canceled), true)
+			return
+		}
+		if next.ctx != nil && next.ctx.Err() != nil {
+			reject(next.ctx.Err())
+			return
+		}
+		cb(resolve, reject)
+	}
+	if f.parentCtx != nil {
+		next.bind(f.parentCtx)
+	}
+
+	subscriber := &futureSubscriber{run: next.activeSync}
+	next.cancelCb = func() {
+		if f.unsubscribe(subscriber) {
 			f.Cancel()
-		},
-		done: make(chan struct{}),
+		}
 	}
 
-	select {
-	case <-f.done:
-		f.next.active()
-	default:
+	if !f.subscribe(subscriber) {
+		next.active()
 	}
-	return f.next
+	return next
 }
 
-// Finally is called when Future is processed either resolved or rejected. It returns a new Future.
-func (f *Future[T]) Finally(cb func(T, error) (T, error)) *Future[T] {
-	f.mu.Lock()
-	defer f.mu.Unlock()
+// thenFuture returns a Future resolved with the value of f mapped by cb.
+func thenFuture[T any, U any](f *Future[T], cb func(T) (U, error)) *Future[U] {
+	return chain(f, func(resolve func(U), reject func(error)) {
+		if f.result.IsError() {
+			reject(f.result.Error())
+			return
+		}
+		newValue, err := cb(f.result.MustGet())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
+}
 
-	f.next = &Future[T]{
-		cb: func(resolve func(T), reject func(error)) {
-			newValue, err := cb(f.result.Get())
-			if err != nil {
-				reject(err)
+// flatMapFuture returns a Future settled like the Future returned by cb for the value of f.
+func flatMapFuture[T any, U any](f *Future[T], cb func(T) *Future[U]) *Future[U] {
+	return chain(f, func(resolve func(U), reject func(error)) {
+		if f.result.IsError() {
+			reject(f.result.Error())
+			return
+		}
+		cb(f.result.MustGet()).Subscribe(func(result Result[U]) {
+			if result.IsError() {
+				reject(result.Error())
 				return
 			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
-			f.Cancel()
+			resolve(result.MustGet())
+		})
+	})
+}
+
+// recoverFuture returns a Future settled like f, or like the Future returned by cb for the error of f.
+func recoverFuture[T any](f *Future[T], cb func(error) *Future[T]) *Future[T] {
+	return chain(f, func(resolve func(T), reject func(error)) {
+		if f.result.IsOk() {
+			resolve(f.result.MustGet())
+			return
+		}
+		cb(f.result.Error()).Subscribe(func(result Result[T]) {
+			if result.IsError() {
+				reject(result.Error())
+				return
+			}
+			resolve(result.MustGet())
+		})
+	})
+}
+
+// Subscribe registers a callback receiving the Result of the Future once it is settled.
+// Callbacks run in subscription order, or right away when the Future is already settled.
+// Collect returns as soon as the Future is settled, possibly before the callbacks have run.
+// Callbacks have no error to report a panic with: their panics are discarded, unless panic
+// recovery was disabled with WithPanicRecovery, in which case they are raised again by the
+// goroutine running the callbacks. It returns a function removing the callback.
+func (f *Future[T]) Subscribe(cb func(Result[T])) (unsubscribe func()) {
+	subscriber := &futureSubscriber{
+		run: func() {
+			cb(f.result)
 		},
-		done: make(chan struct{}),
 	}
 
-	select {
-	case <-f.done:
-		f.next.active()
-	default:
+	if !f.subscribe(subscriber) {
+		f.runSubscribers([]*futureSubscriber{subscriber})
+		return func() {}
 	}
-	return f.next
+	return func() {
+		f.unsubscribe(subscriber)
+	}
+}
+
+// OnComplete registers a callback receiving the value and error of the Future once it is settled.
+// Its panics are handled like the ones of Subscribe callbacks.
+func (f *Future[T]) OnComplete(cb func(T, error)) {
+	f.Subscribe(func(result Result[T]) {
+		cb(result.Get())
+	})
 }
 
-// Cancel cancels the Future chain.
+// Cancel cancels the Future chain. A pending Future is rejected with context.Canceled,
+// which its continuations receive, and it is unsubscribed from the Future it was chained
+// to, which is cancelled too once it has no subscriber left.
 func (f *Future[T]) Cancel() {
-	f.mu.Lock()
-	defer f.mu.Unlock()
+	if f.cancelCtx != nil {
+		f.cancelCtx()
+	}
+	if f.onCancel != nil {
+		f.onCancel()
+	} else {
+		f.settle(Err[T](context.Canceled), true)
+	}
 
-	f.next = nil
 	if f.cancelCb != nil {
 		f.cancelCb()
 	}
@@ -168,14 +411,62 @@ func (f *Future[T]) Collect() (T, error) {
 	return f.result.Get()
 }
 
+// CollectContext awaits and return result of the Future, or the error of ctx when
+// it is done first. The Future keeps running in the latter case.
+func (f *Future[T]) CollectContext(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.result.Get()
+	case <-ctx.Done():
+		return empty[T](), ctx.Err()
+	}
+}
+
+// CollectTimeout awaits and return result of the Future, or ErrFutureTimeout when
+// it is not settled within timeout. The Future keeps running in the latter case.
+func (f *Future[T]) CollectTimeout(timeout time.Duration) (T, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-f.done:
+		return f.result.Get()
+	case <-timer.C:
+		return empty[T](), ErrFutureTimeout
+	}
+}
+
 // Result wraps Collect and returns a Result.
 func (f *Future[T]) Result() Result[T] {
 	return TupleToResult(f.Collect())
 }
 
+// ResultContext wraps CollectContext and returns a Result.
+func (f *Future[T]) ResultContext(ctx context.Context) Result[T] {
+	return TupleToResult(f.CollectContext(ctx))
+}
+
+// ResultTimeout wraps CollectTimeout and returns a Result.
+func (f *Future[T]) ResultTimeout(timeout time.Duration) Result[T] {
+	return TupleToResult(f.CollectTimeout(timeout))
+}
+
 // Either wraps Collect and returns a Either.
 func (f *Future[T]) Either() Either[error, T] {
-	v, err := f.Collect()
+	return tupleToEither(f.Collect())
+}
+
+// EitherContext wraps CollectContext and returns a Either.
+func (f *Future[T]) EitherContext(ctx context.Context) Either[error, T] {
+	return tupleToEither(f.CollectContext(ctx))
+}
+
+// EitherTimeout wraps CollectTimeout and returns a Either.
+func (f *Future[T]) EitherTimeout(timeout time.Duration) Either[error, T] {
+	return tupleToEither(f.CollectTimeout(timeout))
+}
+
+func tupleToEither[T any](v T, err error) Either[error, T] {
 	if err != nil {
 		return Left[error, T](err)
 	}
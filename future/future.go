@@ -3,6 +3,7 @@ package future
 import (
 	"sync"
 
+	"github.com/tperdue321/mo"
 	"github.com/tperdue321/mo/either"
 	"github.com/tperdue321/mo/result"
 )
@@ -27,7 +28,7 @@ type Future[A any] struct {
 
 	cb       func(func(A), func(error))
 	cancelCb func()
-	next     *Future[A]
+	next     func()
 	done     chan struct{}
 	result   result.Result[A]
 }
@@ -46,7 +47,7 @@ func (f *Future[A]) resolve(value A) {
 
 	f.result = result.Ok(value)
 	if f.next != nil {
-		f.next.activeSync()
+		f.next()
 	}
 	close(f.done)
 }
@@ -55,120 +56,105 @@ func (f *Future[A]) reject(err error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.result = Err[A](err)
+	f.result = result.Err[A](err)
 	if f.next != nil {
-		f.next.activeSync()
+		f.next()
 	}
 	close(f.done)
 }
 
-// Ahen is called when Future is resolved. It returns a new Future.
-func (f *Future[A]) Ahen(cb func(A) (A, error)) *Future[A] {
-	f.mu.Lock()
-	defer f.mu.Unlock()
-
-	f.next = &Future[A]{
-		cb: func(resolve func(A), reject func(error)) {
-			if f.result.IsError() {
-				reject(f.result.Error())
-				return
-			}
-			newValue, err := cb(f.result.MustGet())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
-			f.Cancel()
-		},
-		done: make(chan struct{}),
-	}
-
-	select {
-	case <-f.done:
-		f.next.active()
-	default:
-	}
-	return f.next
+// Then is called when Future is resolved. It returns a new Future.
+func (f *Future[A]) Then(cb func(A) (A, error)) *Future[A] {
+	return chain(f, func(resolve func(A), reject func(error)) {
+		if f.result.IsError() {
+			reject(f.result.Error())
+			return
+		}
+		newValue, err := cb(f.result.MustGet())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
 }
 
 // Catch is called when Future is rejected. It returns a new Future.
 func (f *Future[A]) Catch(cb func(error) (A, error)) *Future[A] {
-	f.mu.Lock()
-	defer f.mu.Unlock()
-
-	f.next = &Future[A]{
-		cb: func(resolve func(A), reject func(error)) {
-			if f.result.IsOk() {
-				resolve(f.result.MustGet())
-				return
-			}
-			newValue, err := cb(f.result.Error())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
-			f.Cancel()
-		},
-		done: make(chan struct{}),
-	}
-
-	select {
-	case <-f.done:
-		f.next.active()
-	default:
-	}
-	return f.next
+	return chain(f, func(resolve func(A), reject func(error)) {
+		if f.result.IsOk() {
+			resolve(f.result.MustGet())
+			return
+		}
+		newValue, err := cb(f.result.Error())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
 }
 
 // Finally is called when Future is processed either resolved or rejected. It returns a new Future.
 func (f *Future[A]) Finally(cb func(A, error) (A, error)) *Future[A] {
+	return chain(f, func(resolve func(A), reject func(error)) {
+		newValue, err := cb(f.result.Get(), f.result.Error())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
+}
+
+// chain registers the Future running cb once f is settled. The new Future may hold
+// another type than f, which lets FutureWrapper change the type along the chain.
+func chain[A, B any](f *Future[A], cb func(resolve func(B), reject func(error))) *Future[B] {
+	return chainWithCancel(f, cb, func() {})
+}
+
+// chainWithCancel is chain with onCancel run when the new Future is cancelled, once f
+// has been cancelled.
+func chainWithCancel[A, B any](f *Future[A], cb func(resolve func(B), reject func(error)), onCancel func()) *Future[B] {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.next = &Future[A]{
-		cb: func(resolve func(A), reject func(error)) {
-			newValue, err := cb(f.result.Get())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
+	next := &Future[B]{
+		cb: cb,
 		cancelCb: func() {
 			f.Cancel()
+			onCancel()
 		},
 		done: make(chan struct{}),
 	}
+	f.next = next.activeSync
 
 	select {
 	case <-f.done:
-		f.next.active()
+		next.active()
 	default:
 	}
-	return f.next
+	return next
 }
 
 // Cancel cancels the Future chain.
 func (f *Future[A]) Cancel() {
 	f.mu.Lock()
-	defer f.mu.Unlock()
-
 	f.next = nil
-	if f.cancelCb != nil {
-		f.cancelCb()
+	cancelCb := f.cancelCb
+	f.mu.Unlock()
+
+	// cancelCb locks the previous Futures of the chain, which lock their next Future
+	// while settling it: it must run without holding the lock.
+	if cancelCb != nil {
+		cancelCb()
 	}
 }
 
 // Collect awaits and return result of the Future.
 func (f *Future[A]) Collect() (A, error) {
 	<-f.done
-	return f.result.Get()
+	return f.result.Get(), f.result.Error()
 }
 
 // Result wraps Collect and returns a Result.
@@ -176,6 +162,30 @@ func (f *Future[A]) Result() result.Result[A] {
 	return result.TupleToResult(f.Collect())
 }
 
+// FromMo converts a root mo.Future into a Future.
+func FromMo[A any](future *mo.Future[A]) *Future[A] {
+	return NewFuture(func(resolve func(A), reject func(error)) {
+		value, err := future.Collect()
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(value)
+	})
+}
+
+// ToMo converts the Future into a root mo.Future.
+func (f *Future[A]) ToMo() *mo.Future[A] {
+	return mo.NewFuture(func(resolve func(A), reject func(error)) {
+		value, err := f.Collect()
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(value)
+	})
+}
+
 // Either wraps Collect and returns a Either.
 func (f *Future[A]) Either() either.Either[error, A] {
 	v, err := f.Collect()
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo/either"
+	"github.com/tperdue321/mo/result"
 )
 
 func assertAndIncrement(is *assert.Assertions, expected int, i *int32) {
@@ -213,24 +215,28 @@ func TestFutureCancel(t *testing.T) {
 
 	var i int32 = 0
 
-	future := NewFuture[int](func(resolve func(int), reject func(error)) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	wrapped := NewFuture[int](func(resolve func(int), reject func(error)) {
 		assertAndIncrement(is, 0, &i)
+		close(started)
 
-		time.Sleep(5 * time.Millisecond)
+		<-release
 
 		resolve(42)
-	}).Then(func(value int) (int, error) {
-		assertAndIncrement(is, 3, &i)
+	})
+	future := wrapped.Then(func(value int) (int, error) {
 		is.Fail("should not enter here")
 
 		return 21, assert.AnError
 	})
 
-	time.Sleep(1 * time.Millisecond)
+	<-started
 	assertAndIncrement(is, 1, &i)
 	future.Cancel()
 
-	time.Sleep(10 * time.Millisecond)
+	close(release)
+	_, _ = wrapped.Collect()
 	assertAndIncrement(is, 2, &i)
 }
 
@@ -289,25 +295,25 @@ func TestFutureCancelTerminated(t *testing.T) {
 func TestFutureResultResult(t *testing.T) {
 	is := assert.New(t)
 
-	result := NewFuture[int](func(resolve func(int), reject func(error)) {
+	res := NewFuture[int](func(resolve func(int), reject func(error)) {
 		reject(assert.AnError)
 	}).Result()
 
-	is.Equal(Err[int](assert.AnError), result)
-	is.NotNil(result.Error())
-	is.Equal(assert.AnError, result.Error())
+	is.Equal(result.Err[int](assert.AnError), res)
+	is.NotNil(res.Error())
+	is.Equal(assert.AnError, res.Error())
 }
 
 func TestFutureResultEither(t *testing.T) {
 	is := assert.New(t)
 
-	either := NewFuture[int](func(resolve func(int), reject func(error)) {
+	e := NewFuture[int](func(resolve func(int), reject func(error)) {
 		reject(assert.AnError)
 	}).Either()
 
-	is.Equal(Left[error, int](assert.AnError), either)
-	is.NotNil(either.Left())
-	is.Equal(assert.AnError, either.MustLeft())
+	is.Equal(either.Left[error, int](assert.AnError), e)
+	is.NotNil(e.Left())
+	is.Equal(assert.AnError, e.MustLeft())
 }
 
 func TestFutureCompleteBeforeThen(t *testing.T) {
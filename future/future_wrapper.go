@@ -1,111 +1,141 @@
 package future
 
+import (
+	"sync"
+
+	"github.com/tperdue321/mo"
+)
+
+// FutureWrapper wraps a Future[A] to chain callbacks producing a Future of another type B.
 type FutureWrapper[A, B any] struct {
-	f Future[A]
+	f *Future[A]
 }
 
-func NewFutureWrapper[A, B any](cb func(resolve func(A), reject func(error))) *FutureWrapper[A, B] {
-	fw := FutureWrapper[A, B]{
-		f: Future[A]{
-			cb:       cb,
-			cancelCb: func() {},
-			done:     make(chan struct{}),
-		},
+// NewFutureWrapper instanciate a new future and wraps it.
+func NewFutureWrapper[A, B any](cb func(resolve func(A), reject func(error))) FutureWrapper[A, B] {
+	return WrapFuture[A, B](NewFuture(cb))
+}
+
+// WrapFuture wraps an existing Future.
+func WrapFuture[A, B any](future *Future[A]) FutureWrapper[A, B] {
+	return FutureWrapper[A, B]{
+		f: future,
 	}
+}
 
-	fw.f.active()
+// Unwrap returns the wrapped Future.
+func (fw FutureWrapper[A, B]) Unwrap() *Future[A] {
+	return fw.f
+}
 
-	return &fw
+// Then is called when Future is resolved. It returns a new Future of type B.
+func (fw FutureWrapper[A, B]) Then(cb func(A) (B, error)) *Future[B] {
+	f := fw.f
+	return chain(f, func(resolve func(B), reject func(error)) {
+		if f.result.IsError() {
+			reject(f.result.Error())
+			return
+		}
+		newValue, err := cb(f.result.MustGet())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
 }
 
-// Then is called when Future is resolved. It returns a new Future.
-func (fw *FutureWrapper[A, B]) Then(cb func(A) (B, error)) *Future[B] {
-	fw.f.mu.Lock()
-	defer fw.f.mu.Unlock()
-
-	fw.f.next = &Future[B]{
-		cb: func(resolve func(B), reject func(error)) {
-			if f.result.IsError() {
-				reject(f.result.Error())
-				return
-			}
-			newValue, err := cb(f.result.MustGet())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
-			f.Cancel()
-		},
-		done: make(chan struct{}),
-	}
+// Finally is called when Future is processed either resolved or rejected. It returns a new Future of type B.
+func (fw FutureWrapper[A, B]) Finally(cb func(A, error) (B, error)) *Future[B] {
+	f := fw.f
+	return chain(f, func(resolve func(B), reject func(error)) {
+		newValue, err := cb(f.result.Get(), f.result.Error())
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(newValue)
+	})
+}
 
-	select {
-	case <-fw.f.done:
-		fw.f.next.active()
-	default:
-	}
-	return fw.f.next
+// FlatMap is called when Future is resolved. It returns a new Future settled like the
+// Future returned by cb. Cancelling it also cancels the Future returned by cb.
+func (fw FutureWrapper[A, B]) FlatMap(cb func(A) *Future[B]) *Future[B] {
+	f := fw.f
+	inner := &cancelLink{}
+	return chainWithCancel(f, func(resolve func(B), reject func(error)) {
+		if f.result.IsError() {
+			reject(f.result.Error())
+			return
+		}
+		future := cb(f.result.MustGet())
+		if !inner.set(future.Cancel) {
+			return
+		}
+		forward(future, func(value B) B {
+			return value
+		}, resolve, reject)
+	}, inner.cancel)
 }
 
-// Catch is called when Future is rejected. It returns a new Future.
-func (f *FutureWrapper[A, B]) Catch(cb func(error) (B, error)) *Future[B] {
-	f.mu.Lock()
-	defer f.mu.Unlock()
-
-	f.next = &Future[B]{
-		cb: func(resolve func(B), reject func(error)) {
-			if f.result.IsOk() {
-				resolve(f.result.MustGet())
-				return
-			}
-			newValue, err := cb(f.result.Error())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
-			f.Cancel()
-		},
-		done: make(chan struct{}),
-	}
+// Zip returns a Future resolved with the values of both Futures. It is rejected with
+// the error of the wrapped Future first, then with the error of other. Cancelling it
+// also cancels other.
+func (fw FutureWrapper[A, B]) Zip(other *Future[B]) *Future[mo.Tuple2[A, B]] {
+	f := fw.f
+	return chainWithCancel(f, func(resolve func(mo.Tuple2[A, B]), reject func(error)) {
+		if f.result.IsError() {
+			reject(f.result.Error())
+			return
+		}
+		a := f.result.MustGet()
+		forward(other, func(b B) mo.Tuple2[A, B] {
+			return mo.T2(a, b)
+		}, resolve, reject)
+	}, other.Cancel)
+}
 
-	select {
-	case <-f.done:
-		f.next.active()
-	default:
-	}
-	return f.next
+// forward settles resolve or reject like future once it is settled, mapping its value
+// with project.
+func forward[B, C any](future *Future[B], project func(B) C, resolve func(C), reject func(error)) {
+	chain(future, func(func(struct{}), func(error)) {
+		if future.result.IsError() {
+			reject(future.result.Error())
+			return
+		}
+		resolve(project(future.result.MustGet()))
+	})
+}
+
+// cancelLink cancels a Future which may only be known after the cancellation.
+type cancelLink struct {
+	mu        sync.Mutex
+	cancelled bool
+	cancelCb  func()
 }
 
-// Finally is called when Future is processed either resolved or rejected. It returns a new Future.
-func (f *FutureWrapper[A, B]) Finally(cb func(A, error) (A, error)) *Future[B] {
-	f.mu.Lock()
-	defer f.mu.Unlock()
-
-	f.next = &Future[T]{
-		cb: func(resolve func(T), reject func(error)) {
-			newValue, err := cb(f.result.Get())
-			if err != nil {
-				reject(err)
-				return
-			}
-			resolve(newValue)
-		},
-		cancelCb: func() {
-			f.Cancel()
-		},
-		done: make(chan struct{}),
+// set registers cancelCb. It returns false, after calling cancelCb, when the link is
+// already cancelled.
+func (l *cancelLink) set(cancelCb func()) bool {
+	l.mu.Lock()
+	cancelled := l.cancelled
+	l.cancelCb = cancelCb
+	l.mu.Unlock()
+
+	if cancelled {
+		cancelCb()
+		return false
 	}
+	return true
+}
+
+func (l *cancelLink) cancel() {
+	l.mu.Lock()
+	l.cancelled = true
+	cancelCb := l.cancelCb
+	l.mu.Unlock()
 
-	select {
-	case <-f.done:
-		f.next.active()
-	default:
+	if cancelCb != nil {
+		cancelCb()
 	}
-	return f.next
 }
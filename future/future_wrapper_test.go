@@ -1 +1,198 @@
 package future
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo"
+)
+
+func TestFutureWrapperThen(t *testing.T) {
+	is := assert.New(t)
+
+	result, err := NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).Then(func(value int) (string, error) {
+		return strconv.Itoa(value), nil
+	}).Collect()
+
+	is.Equal("42", result)
+	is.Nil(err)
+
+	result, err = NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		reject(assert.AnError)
+	}).Then(func(value int) (string, error) {
+		is.Fail("should not enter here")
+		return "", nil
+	}).Collect()
+
+	is.Equal("", result)
+	is.Equal(assert.AnError, err)
+
+	result, err = NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).Then(func(value int) (string, error) {
+		return "", assert.AnError
+	}).Catch(func(err error) (string, error) {
+		return "recovered", nil
+	}).Collect()
+
+	is.Equal("recovered", result)
+	is.Nil(err)
+}
+
+func TestFutureWrapperFinally(t *testing.T) {
+	is := assert.New(t)
+
+	result, err := WrapFuture[int, string](NewFuture(func(resolve func(int), reject func(error)) {
+		reject(assert.AnError)
+	})).Finally(func(value int, err error) (string, error) {
+		is.Equal(0, value)
+		is.Equal(assert.AnError, err)
+		return "done", nil
+	}).Collect()
+
+	is.Equal("done", result)
+	is.Nil(err)
+}
+
+func TestFutureWrapperFlatMap(t *testing.T) {
+	is := assert.New(t)
+
+	result, err := NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).FlatMap(func(value int) *Future[string] {
+		return NewFuture(func(resolve func(string), reject func(error)) {
+			time.Sleep(time.Millisecond)
+			resolve(strconv.Itoa(value))
+		})
+	}).Collect()
+
+	is.Equal("42", result)
+	is.Nil(err)
+
+	_, err = NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).FlatMap(func(value int) *Future[string] {
+		return NewFuture(func(resolve func(string), reject func(error)) {
+			reject(assert.AnError)
+		})
+	}).Collect()
+
+	is.Equal(assert.AnError, err)
+}
+
+func TestFutureWrapperZip(t *testing.T) {
+	is := assert.New(t)
+
+	result, err := NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).Zip(NewFuture(func(resolve func(string), reject func(error)) {
+		time.Sleep(time.Millisecond)
+		resolve("foo")
+	})).Collect()
+
+	is.Equal(mo.T2(42, "foo"), result)
+	is.Nil(err)
+
+	_, err = NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).Zip(NewFuture(func(resolve func(string), reject func(error)) {
+		reject(assert.AnError)
+	})).Collect()
+
+	is.Equal(assert.AnError, err)
+}
+
+func TestFutureWrapperCancel(t *testing.T) {
+	is := assert.New(t)
+
+	var i int32 = 0
+
+	release := make(chan struct{})
+	wrapped := NewFuture[int](func(resolve func(int), reject func(error)) {
+		<-release
+		resolve(42)
+	})
+	future := WrapFuture[int, string](wrapped).Then(func(value int) (string, error) {
+		is.Fail("should not enter here")
+		return "", nil
+	})
+
+	assertAndIncrement(is, 0, &i)
+	future.Cancel()
+
+	close(release)
+	_, _ = wrapped.Collect()
+	assertAndIncrement(is, 1, &i)
+}
+
+func TestFutureWrapperFlatMapCancel(t *testing.T) {
+	is := assert.New(t)
+
+	called := make(chan struct{})
+	release := make(chan struct{})
+	source := NewFuture(func(resolve func(string), reject func(error)) {
+		<-release
+		resolve("foo")
+	})
+	future := NewFutureWrapper[int, string](func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).FlatMap(func(value int) *Future[string] {
+		defer close(called)
+		return source.Then(func(value string) (string, error) {
+			is.Fail("should not enter here")
+			return value, nil
+		})
+	})
+
+	<-called
+	future.Cancel()
+
+	close(release)
+	_, _ = source.Collect()
+}
+
+func TestFutureWrapperZipCancel(t *testing.T) {
+	is := assert.New(t)
+
+	release := make(chan struct{})
+	source := NewFuture(func(resolve func(string), reject func(error)) {
+		<-release
+		resolve("foo")
+	})
+	wrapped := NewFuture(func(resolve func(int), reject func(error)) {
+		<-release
+		resolve(42)
+	})
+	future := WrapFuture[int, string](wrapped).Zip(source.Then(func(value string) (string, error) {
+		is.Fail("should not enter here")
+		return value, nil
+	}))
+
+	future.Cancel()
+
+	close(release)
+	_, _ = source.Collect()
+	_, _ = wrapped.Collect()
+}
+
+func TestFutureMoConversion(t *testing.T) {
+	is := assert.New(t)
+
+	result, err := FromMo(mo.NewFuture(func(resolve func(int), reject func(error)) {
+		resolve(42)
+	})).Collect()
+
+	is.Equal(42, result)
+	is.Nil(err)
+
+	result, err = NewFuture(func(resolve func(int), reject func(error)) {
+		reject(assert.AnError)
+	}).ToMo().Collect()
+
+	is.Equal(0, result)
+	is.Equal(assert.AnError, err)
+}
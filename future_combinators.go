@@ -0,0 +1,147 @@
+package mo
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrRaceEmpty is the error Race of no futures is rejected with.
+var ErrRaceEmpty = errors.New("race requires at least one future")
+
+// AggregateError gathers the errors of every rejected Future passed to Any.
+type AggregateError struct {
+	Errors []error
+}
+
+// Error implements the error interface.
+func (e *AggregateError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return "all futures were rejected: [" + strings.Join(messages, "; ") + "]"
+}
+
+// Unwrap returns the aggregated errors.
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
+
+// All returns a Future resolved with the values of every future, in order. It is rejected
+// with the first error, and the futures still pending are cancelled.
+func All[T any](futures ...*Future[T]) *Future[[]T] {
+	return NewFuture(func(resolve func([]T), reject func(error)) {
+		values := make([]T, len(futures))
+		var err error
+
+		settle(futures, func(index int, result Result[T]) bool {
+			if result.IsError() {
+				err = result.Error()
+				return true
+			}
+			values[index] = result.MustGet()
+			return false
+		})
+
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(values)
+	})
+}
+
+// AllSettled returns a Future resolved with the Result of every future, in order, once
+// they are all settled. It is never rejected.
+func AllSettled[T any](futures ...*Future[T]) *Future[[]Result[T]] {
+	return NewFuture(func(resolve func([]Result[T]), reject func(error)) {
+		results := make([]Result[T], len(futures))
+
+		settle(futures, func(index int, result Result[T]) bool {
+			results[index] = result
+			return false
+		})
+
+		resolve(results)
+	})
+}
+
+// Race returns a Future settled like the first future to settle. The other futures are
+// cancelled. Race of no futures is rejected with ErrRaceEmpty.
+func Race[T any](futures ...*Future[T]) *Future[T] {
+	return NewFuture(func(resolve func(T), reject func(error)) {
+		if len(futures) == 0 {
+			reject(ErrRaceEmpty)
+			return
+		}
+
+		var first Result[T]
+
+		settle(futures, func(index int, result Result[T]) bool {
+			first = result
+			return true
+		})
+
+		if first.IsError() {
+			reject(first.Error())
+			return
+		}
+		resolve(first.MustGet())
+	})
+}
+
+// Any returns a Future resolved with the first future to resolve. The other futures are
+// cancelled. When every future is rejected, it is rejected with an *AggregateError
+// holding the errors in order.
+func Any[T any](futures ...*Future[T]) *Future[T] {
+	return NewFuture(func(resolve func(T), reject func(error)) {
+		errs := make([]error, len(futures))
+		var value Option[T]
+
+		settle(futures, func(index int, result Result[T]) bool {
+			if result.IsOk() {
+				value = Some(result.MustGet())
+				return true
+			}
+			errs[index] = result.Error()
+			return false
+		})
+
+		if v, ok := value.Get(); ok {
+			resolve(v)
+			return
+		}
+		reject(&AggregateError{Errors: errs})
+	})
+}
+
+// settle waits for the futures concurrently and calls onSettled with the index and the
+// Result of each future as it settles, until onSettled returns true. The futures still
+// pending afterwards are cancelled, since the result no longer depends on them.
+func settle[T any](futures []*Future[T], onSettled func(index int, result Result[T]) bool) {
+	settled := make(chan Tuple2[int, Result[T]], len(futures))
+	stop := make(chan struct{})
+
+	for i, future := range futures {
+		go func(index int, future *Future[T]) {
+			select {
+			case <-future.done:
+				settled <- T2(index, future.result)
+			case <-stop:
+			}
+		}(i, future)
+	}
+
+	for range futures {
+		if onSettled((<-settled).Unpack()) {
+			break
+		}
+	}
+	close(stop)
+
+	for _, future := range futures {
+		if !future.settled() {
+			future.Cancel()
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package mo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func resolveAfter[T any](value T, delay time.Duration) *Future[T] {
+	return NewFuture(func(resolve func(T), reject func(error)) {
+		time.Sleep(delay)
+		resolve(value)
+	})
+}
+
+func rejectAfter[T any](err error, delay time.Duration) *Future[T] {
+	return NewFuture(func(resolve func(T), reject func(error)) {
+		time.Sleep(delay)
+		reject(err)
+	})
+}
+
+// pendingUntilCancelled returns a Future that settles only once cancelled.
+func pendingUntilCancelled[T any]() *Future[T] {
+	return NewFutureWithContext(context.Background(), func(ctx context.Context, resolve func(T), reject func(error)) {
+		<-ctx.Done()
+	})
+}
+
+func TestAll(t *testing.T) {
+	is := assert.New(t)
+
+	values, err := All(
+		resolveAfter(1, 20*time.Millisecond),
+		resolveAfter(2, 0),
+		resolveAfter(3, 10*time.Millisecond),
+	).Collect()
+	is.NoError(err)
+	is.Equal([]int{1, 2, 3}, values)
+
+	values, err = All[int]().Collect()
+	is.NoError(err)
+	is.Equal([]int{}, values)
+
+	pending := pendingUntilCancelled[int]()
+	_, err = All(resolveAfter(1, 0), rejectAfter[int](assert.AnError, 5*time.Millisecond), pending).Collect()
+	is.Equal(assert.AnError, err)
+
+	_, err = pending.Collect()
+	is.ErrorIs(err, context.Canceled)
+}
+
+func TestAllSettled(t *testing.T) {
+	is := assert.New(t)
+
+	results, err := AllSettled(
+		resolveAfter(1, 10*time.Millisecond),
+		rejectAfter[int](assert.AnError, 0),
+		resolveAfter(3, 0),
+	).Collect()
+	is.NoError(err)
+	is.Equal([]Result[int]{Ok(1), Err[int](assert.AnError), Ok(3)}, results)
+
+	results, err = AllSettled[int]().Collect()
+	is.NoError(err)
+	is.Equal([]Result[int]{}, results)
+}
+
+func TestRace(t *testing.T) {
+	is := assert.New(t)
+
+	pending := pendingUntilCancelled[int]()
+	value, err := Race(resolveAfter(1, 20*time.Millisecond), resolveAfter(2, 0), pending).Collect()
+	is.NoError(err)
+	is.Equal(2, value)
+
+	_, err = pending.Collect()
+	is.ErrorIs(err, context.Canceled)
+
+	_, err = Race(resolveAfter(1, 20*time.Millisecond), rejectAfter[int](assert.AnError, 0)).Collect()
+	is.Equal(assert.AnError, err)
+
+	_, err = Race[int]().Collect()
+	is.Equal(ErrRaceEmpty, err)
+}
+
+func TestAny(t *testing.T) {
+	is := assert.New(t)
+
+	pending := pendingUntilCancelled[int]()
+	value, err := Any(rejectAfter[int](assert.AnError, 0), resolveAfter(2, 10*time.Millisecond), pending).Collect()
+	is.NoError(err)
+	is.Equal(2, value)
+
+	_, err = pending.Collect()
+	is.ErrorIs(err, context.Canceled)
+
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+	_, err = Any(rejectAfter[int](err1, 10*time.Millisecond), rejectAfter[int](err2, 0)).Collect()
+
+	var aggregate *AggregateError
+	is.True(errors.As(err, &aggregate))
+	is.Equal([]error{err1, err2}, aggregate.Errors)
+	is.Equal("all futures were rejected: [error 1; error 2]", err.Error())
+
+	_, err = Any[int]().Collect()
+	is.True(errors.As(err, &aggregate))
+	is.Empty(aggregate.Errors)
+}
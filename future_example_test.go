@@ -1,6 +1,10 @@
 package mo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
+)
 
 func ExampleNewFuture_resolve() {
 	value, err := NewFuture(func(resolve func(string), reject func(error)) {
@@ -26,6 +30,25 @@ func ExampleNewFuture_reject() {
 	// failure
 }
 
+func ExampleNewFutureWithContext() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	value, err := NewFutureWithContext(ctx, func(ctx context.Context, resolve func(string), reject func(error)) {
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			resolve("foobar")
+		}
+	}).Collect()
+
+	fmt.Println(value)
+	fmt.Println(err)
+	// Output:
+	//
+	// context deadline exceeded
+}
+
 func ExampleFuture_Collect_resolve() {
 	value, err := NewFuture(func(resolve func(string), reject func(error)) {
 		resolve("foobar")
@@ -1,6 +1,7 @@
 package mo
 
 import (
+	"context"
 	"fmt"
 	"sync/atomic"
 	"testing"
@@ -324,3 +325,367 @@ func TestFutureCompleteBeforeThen(t *testing.T) {
 		return in, nil
 	}).Collect() // deadlock
 }
+
+func TestFutureWithContextResolve(t *testing.T) {
+	is := assert.New(t)
+
+	value, err := NewFutureWithContext(context.Background(), func(ctx context.Context, resolve func(int), reject func(error)) {
+		is.NoError(ctx.Err())
+		resolve(42)
+	}).Then(func(in int) (int, error) {
+		return in + 1, nil
+	}).Collect()
+
+	is.NoError(err)
+	is.Equal(43, value)
+}
+
+func TestFutureWithContextCancel(t *testing.T) {
+	is := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+
+	var thenCalled int32
+	fut := NewFutureWithContext(ctx, func(ctx context.Context, resolve func(int), reject func(error)) {
+		<-ctx.Done()
+		close(stopped)
+	}).Then(func(in int) (int, error) {
+		atomic.AddInt32(&thenCalled, 1)
+		return in, nil
+	}).Catch(func(err error) (int, error) {
+		return 0, fmt.Errorf("wrapped: %w", err)
+	})
+
+	cancel()
+
+	_, err := fut.Collect()
+	is.ErrorIs(err, context.Canceled)
+	is.Equal(int32(0), atomic.LoadInt32(&thenCalled))
+	<-stopped
+}
+
+func TestFutureWithContextDeadline(t *testing.T) {
+	is := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := NewFutureWithContext(ctx, func(ctx context.Context, resolve func(int), reject func(error)) {
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			resolve(42)
+		}
+	}).Finally(func(value int, err error) (int, error) {
+		return value, err
+	}).Collect()
+
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestFutureWithContextFutureCancel(t *testing.T) {
+	is := assert.New(t)
+
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+
+	fut := NewFutureWithContext(context.Background(), func(ctx context.Context, resolve func(int), reject func(error)) {
+		close(started)
+		<-ctx.Done()
+		close(stopped)
+	})
+	child := fut.Then(func(in int) (int, error) {
+		return in, nil
+	})
+
+	<-started
+	child.Cancel()
+
+	_, err := child.Collect()
+	is.ErrorIs(err, context.Canceled)
+	_, err = fut.Collect()
+	is.ErrorIs(err, context.Canceled)
+	<-stopped
+}
+
+func TestFutureWithContextFirstSettlementWins(t *testing.T) {
+	is := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	fut := NewFutureWithContext(ctx, func(ctx context.Context, resolve func(int), reject func(error)) {
+		resolve(42)
+	})
+
+	value, err := fut.Collect()
+	cancel()
+
+	is.NoError(err)
+	is.Equal(42, value)
+	value, err = fut.Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+}
+
+func TestFutureMultipleSubscribers(t *testing.T) {
+	is := assert.New(t)
+
+	var i int32 = 0
+
+	fut := NewFuture(func(resolve func(int), reject func(error)) {
+		time.Sleep(5 * time.Millisecond)
+		resolve(21)
+	})
+
+	first := fut.Then(func(value int) (int, error) {
+		assertAndIncrement(is, 0, &i)
+		return value * 2, nil
+	})
+	second := fut.Then(func(value int) (int, error) {
+		assertAndIncrement(is, 1, &i)
+		return value + 1, nil
+	})
+	fut.OnComplete(func(value int, err error) {
+		assertAndIncrement(is, 2, &i)
+		is.Equal(21, value)
+		is.NoError(err)
+	})
+
+	value, err := first.Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+
+	value, err = second.Collect()
+	is.NoError(err)
+	is.Equal(22, value)
+
+	// late continuations still receive the settled value
+	value, err = fut.Then(func(value int) (int, error) {
+		return value, nil
+	}).Collect()
+	is.NoError(err)
+	is.Equal(21, value)
+}
+
+func TestFutureSubscribe(t *testing.T) {
+	is := assert.New(t)
+
+	release := make(chan struct{})
+	fut := NewFuture(func(resolve func(int), reject func(error)) {
+		<-release
+		reject(assert.AnError)
+	})
+
+	var results []Result[int]
+	subscribed := make(chan struct{})
+	fut.Subscribe(func(result Result[int]) {
+		results = append(results, result)
+		close(subscribed)
+	})
+	unsubscribe := fut.Subscribe(func(result Result[int]) {
+		is.Fail("should not enter here")
+	})
+	unsubscribe()
+
+	close(release)
+	<-subscribed
+
+	fut.Subscribe(func(result Result[int]) {
+		results = append(results, result)
+	})
+
+	is.Equal([]Result[int]{Err[int](assert.AnError), Err[int](assert.AnError)}, results)
+}
+
+func TestFutureCollectInSubscriber(t *testing.T) {
+	is := assert.New(t)
+
+	release := make(chan struct{})
+	fut := NewFuture(func(resolve func(int), reject func(error)) {
+		<-release
+		resolve(42)
+	})
+
+	collected := make(chan int)
+	fut.OnComplete(func(value int, err error) {
+		value, _ = fut.Collect()
+		collected <- value
+	})
+
+	close(release)
+	is.Equal(42, <-collected)
+}
+
+func TestFutureCancelSibling(t *testing.T) {
+	is := assert.New(t)
+
+	fut := NewFutureWithContext(context.Background(), func(ctx context.Context, resolve func(int), reject func(error)) {
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Millisecond):
+			resolve(42)
+		}
+	})
+
+	cancelled := fut.Then(func(value int) (int, error) {
+		is.Fail("should not enter here")
+		return value, nil
+	})
+	kept := fut.Then(func(value int) (int, error) {
+		return value, nil
+	})
+
+	cancelled.Cancel()
+
+	_, err := cancelled.Collect()
+	is.ErrorIs(err, context.Canceled)
+
+	value, err := kept.Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+}
+
+func TestFutureWithContextCancelHead(t *testing.T) {
+	is := assert.New(t)
+
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+
+	fut := NewFutureWithContext(context.Background(), func(ctx context.Context, resolve func(int), reject func(error)) {
+		close(started)
+		<-ctx.Done()
+		close(stopped)
+	})
+	child := fut.Then(func(value int) (int, error) {
+		is.Fail("should not enter here")
+		return value, nil
+	})
+	grandchild := child.Catch(func(err error) (int, error) {
+		is.Fail("should not enter here")
+		return 0, nil
+	})
+
+	var completed error
+	fut.OnComplete(func(value int, err error) {
+		completed = err
+	})
+
+	<-started
+	fut.Cancel()
+
+	_, err := fut.Collect()
+	is.ErrorIs(err, context.Canceled)
+	is.ErrorIs(completed, context.Canceled)
+	_, err = child.Collect()
+	is.ErrorIs(err, context.Canceled)
+	_, err = grandchild.Collect()
+	is.ErrorIs(err, context.Canceled)
+	<-stopped
+}
+
+func TestFutureWithContextReleased(t *testing.T) {
+	is := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fut := NewFutureWithContext(ctx, func(ctx context.Context, resolve func(int), reject func(error)) {
+		resolve(21)
+	})
+	child := fut.Then(func(value int) (int, error) {
+		return value * 2, nil
+	})
+
+	value, err := child.Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+
+	// contexts are released once settled, without waiting for the parent context
+	for _, done := range []<-chan struct{}{fut.ctx.Done(), child.ctx.Done()} {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			is.Fail("context not released")
+		}
+	}
+
+	// Futures chained after settlement derive from the parent context
+	value, err = fut.Then(func(value int) (int, error) {
+		return value + 1, nil
+	}).Collect()
+	is.NoError(err)
+	is.Equal(22, value)
+}
+
+func TestFutureCancelRejectsContinuations(t *testing.T) {
+	is := assert.New(t)
+
+	release := make(chan struct{})
+	fut := NewFuture(func(resolve func(int), reject func(error)) {
+		<-release
+		resolve(42)
+	})
+	child := fut.Then(func(value int) (int, error) {
+		is.Fail("should not enter here")
+		return value, nil
+	})
+
+	fut.Cancel()
+	close(release)
+
+	_, err := child.Collect()
+	is.ErrorIs(err, context.Canceled)
+	_, err = fut.Collect()
+	is.ErrorIs(err, context.Canceled)
+}
+
+func TestFutureCollectTimeout(t *testing.T) {
+	is := assert.New(t)
+
+	release := make(chan struct{})
+	fut := NewFuture(func(resolve func(int), reject func(error)) {
+		<-release
+		resolve(42)
+	})
+
+	value, err := fut.CollectTimeout(5 * time.Millisecond)
+	is.Equal(0, value)
+	is.ErrorIs(err, ErrFutureTimeout)
+	is.Equal(Err[int](ErrFutureTimeout), fut.ResultTimeout(time.Millisecond))
+	is.Equal(Left[error, int](ErrFutureTimeout), fut.EitherTimeout(time.Millisecond))
+
+	close(release)
+
+	value, err = fut.CollectTimeout(time.Second)
+	is.NoError(err)
+	is.Equal(42, value)
+	is.Equal(Ok(42), fut.ResultTimeout(time.Second))
+	is.Equal(Right[error, int](42), fut.EitherTimeout(time.Second))
+}
+
+func TestFutureCollectContext(t *testing.T) {
+	is := assert.New(t)
+
+	release := make(chan struct{})
+	fut := NewFuture(func(resolve func(int), reject func(error)) {
+		<-release
+		reject(assert.AnError)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	value, err := fut.CollectContext(ctx)
+	is.Equal(0, value)
+	is.ErrorIs(err, context.DeadlineExceeded)
+	is.Equal(Err[int](context.DeadlineExceeded), fut.ResultContext(ctx))
+	is.Equal(Left[error, int](context.DeadlineExceeded), fut.EitherContext(ctx))
+
+	close(release)
+
+	_, err = fut.CollectContext(context.Background())
+	is.ErrorIs(err, assert.AnError)
+	is.Equal(Err[int](assert.AnError), fut.ResultContext(context.Background()))
+	is.Equal(Left[error, int](assert.AnError), fut.EitherContext(context.Background()))
+}
@@ -9,6 +9,9 @@ func NewIO[R any](f f0[R]) IO[R] {
 
 // IO represents a non-deterministic synchronous computation that
 // can cause side effects, yields a value of type `R` and never fails.
+// IO deliberately takes no RunOption: having no error to turn a panic into, it always
+// lets panics reach the caller of Run. Wrap it with NewIOEitherFromIO, or NewTaskFromIO,
+// to recover them into a *PanicError.
 type IO[R any] struct {
 	unsafePerform f0[R]
 }
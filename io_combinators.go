@@ -0,0 +1,60 @@
+package mo
+
+// Map returns an IO applying f to the value of io. Nothing runs until Run is called.
+func (io IO[R]) Map(f func(R) R) IO[R] {
+	return MapIO(io, f)
+}
+
+// FlatMap returns an IO running the IO returned by f for the value of io.
+func (io IO[R]) FlatMap(f func(R) IO[R]) IO[R] {
+	return FlatMapIO(io, f)
+}
+
+// Tap returns an IO calling f with the value of io, then yielding that value.
+func (io IO[R]) Tap(f func(R)) IO[R] {
+	return NewIO(func() R {
+		value := io.Run()
+		f(value)
+		return value
+	})
+}
+
+// MapIO returns an IO applying f to the value of io. Nothing runs until Run is called.
+func MapIO[A any, B any](io IO[A], f func(A) B) IO[B] {
+	return NewIO(func() B {
+		return f(io.Run())
+	})
+}
+
+// FlatMapIO returns an IO running the IO returned by f for the value of io.
+func FlatMapIO[A any, B any](io IO[A], f func(A) IO[B]) IO[B] {
+	return NewIO(func() B {
+		return f(io.Run()).Run()
+	})
+}
+
+// ZipIO returns an IO running a then b, and yielding both values.
+func ZipIO[A any, B any](a IO[A], b IO[B]) IO[Tuple2[A, B]] {
+	return NewIO(func() Tuple2[A, B] {
+		return T2(a.Run(), b.Run())
+	})
+}
+
+// SequenceIO returns an IO running every IO in order, and yielding their values.
+func SequenceIO[R any](ios []IO[R]) IO[[]R] {
+	return TraverseIO(ios, func(io IO[R]) IO[R] {
+		return io
+	})
+}
+
+// TraverseIO returns an IO running the IO returned by f for every item in order,
+// and yielding their values.
+func TraverseIO[A any, R any](items []A, f func(A) IO[R]) IO[[]R] {
+	return NewIO(func() []R {
+		values := make([]R, 0, len(items))
+		for _, item := range items {
+			values = append(values, f(item).Run())
+		}
+		return values
+	})
+}
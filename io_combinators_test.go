@@ -0,0 +1,82 @@
+package mo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIOCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	var runs int
+	io := NewIO(func() int {
+		runs++
+		return 21
+	})
+
+	var tapped []int
+	composed := io.
+		Map(func(value int) int {
+			return value * 2
+		}).
+		FlatMap(func(value int) IO[int] {
+			return NewIO(func() int {
+				return value + 1
+			})
+		}).
+		Tap(func(value int) {
+			tapped = append(tapped, value)
+		})
+	is.Equal(0, runs)
+
+	is.Equal(43, composed.Run())
+	is.Equal(1, runs)
+	is.Equal([]int{43}, tapped)
+
+	is.Equal(43, composed.Run())
+	is.Equal(2, runs)
+	is.Equal([]int{43, 43}, tapped)
+}
+
+func TestIOTypeChangingCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	io := NewIO(func() int {
+		return 42
+	})
+
+	is.Equal("42", MapIO(io, strconv.Itoa).Run())
+	is.Equal("4242", FlatMapIO(io, func(value int) IO[string] {
+		return NewIO(func() string {
+			return strconv.Itoa(value) + strconv.Itoa(value)
+		})
+	}).Run())
+	is.Equal(T2(42, "foo"), ZipIO(io, NewIO(func() string {
+		return "foo"
+	})).Run())
+
+	var order []int
+	ios := []IO[int]{}
+	for i := 0; i < 3; i++ {
+		i := i
+		ios = append(ios, NewIO(func() int {
+			order = append(order, i)
+			return i * 10
+		}))
+	}
+	is.Equal([]int{0, 10, 20}, SequenceIO(ios).Run())
+	is.Equal([]int{0, 1, 2}, order)
+
+	is.Equal([]string{"1", "2"}, TraverseIO([]int{1, 2}, func(value int) IO[string] {
+		return MapIO(NewIO(func() int {
+			return value
+		}), strconv.Itoa)
+	}).Run())
+	is.Equal([]string{}, TraverseIO([]int{}, func(value int) IO[string] {
+		return NewIO(func() string {
+			return ""
+		})
+	}).Run())
+}
@@ -1,9 +1,10 @@
 package mo
 
 // NewIOEither instanciates a new IO.
-func NewIOEither[R any](f fe0[R]) IOEither[R] {
+func NewIOEither[R any](f fe0[R], opts ...RunOption) IOEither[R] {
 	return IOEither[R]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
@@ -11,10 +12,17 @@ func NewIOEither[R any](f fe0[R]) IOEither[R] {
 // can cause side effects, yields a value of type `R` and can fail.
 type IOEither[R any] struct {
 	unsafePerform fe0[R]
+	options       runOptions
 }
 
 // Run execute the non-deterministic synchronous computation, with side effect.
-func (io IOEither[R]) Run() Either[error, R] {
+func (io IOEither[R]) Run() (either Either[error, R]) {
+	if io.options.recoverPanics {
+		defer recoverPanic(func(err error) {
+			either = Left[error, R](err)
+		})
+	}
+
 	v, err := io.unsafePerform()
 	if err != nil {
 		return Left[error, R](err)
@@ -24,9 +32,10 @@ func (io IOEither[R]) Run() Either[error, R] {
 }
 
 // NewIOEither1 instanciates a new IO1.
-func NewIOEither1[R any, A any](f fe1[R, A]) IOEither1[R, A] {
+func NewIOEither1[R any, A any](f fe1[R, A], opts ...RunOption) IOEither1[R, A] {
 	return IOEither1[R, A]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
@@ -34,10 +43,17 @@ func NewIOEither1[R any, A any](f fe1[R, A]) IOEither1[R, A] {
 // can cause side effects, yields a value of type `R` and can fail.
 type IOEither1[R any, A any] struct {
 	unsafePerform fe1[R, A]
+	options       runOptions
 }
 
 // Run execute the non-deterministic synchronous computation, with side effect.
-func (io IOEither1[R, A]) Run(a A) Either[error, R] {
+func (io IOEither1[R, A]) Run(a A) (either Either[error, R]) {
+	if io.options.recoverPanics {
+		defer recoverPanic(func(err error) {
+			either = Left[error, R](err)
+		})
+	}
+
 	v, err := io.unsafePerform(a)
 	if err != nil {
 		return Left[error, R](err)
@@ -47,9 +63,10 @@ func (io IOEither1[R, A]) Run(a A) Either[error, R] {
 }
 
 // NewIOEither2 instanciates a new IO2.
-func NewIOEither2[R any, A any, B any](f fe2[R, A, B]) IOEither2[R, A, B] {
+func NewIOEither2[R any, A any, B any](f fe2[R, A, B], opts ...RunOption) IOEither2[R, A, B] {
 	return IOEither2[R, A, B]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
@@ -57,10 +74,17 @@ func NewIOEither2[R any, A any, B any](f fe2[R, A, B]) IOEither2[R, A, B] {
 // can cause side effects, yields a value of type `R` and can fail.
 type IOEither2[R any, A any, B any] struct {
 	unsafePerform fe2[R, A, B]
+	options       runOptions
 }
 
 // Run execute the non-deterministic synchronous computation, with side effect.
-func (io IOEither2[R, A, B]) Run(a A, b B) Either[error, R] {
+func (io IOEither2[R, A, B]) Run(a A, b B) (either Either[error, R]) {
+	if io.options.recoverPanics {
+		defer recoverPanic(func(err error) {
+			either = Left[error, R](err)
+		})
+	}
+
 	v, err := io.unsafePerform(a, b)
 	if err != nil {
 		return Left[error, R](err)
@@ -70,9 +94,10 @@ func (io IOEither2[R, A, B]) Run(a A, b B) Either[error, R] {
 }
 
 // NewIOEither3 instanciates a new IO3.
-func NewIOEither3[R any, A any, B any, C any](f fe3[R, A, B, C]) IOEither3[R, A, B, C] {
+func NewIOEither3[R any, A any, B any, C any](f fe3[R, A, B, C], opts ...RunOption) IOEither3[R, A, B, C] {
 	return IOEither3[R, A, B, C]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
@@ -80,10 +105,17 @@ func NewIOEither3[R any, A any, B any, C any](f fe3[R, A, B, C]) IOEither3[R, A,
 // can cause side effects, yields a value of type `R` and can fail.
 type IOEither3[R any, A any, B any, C any] struct {
 	unsafePerform fe3[R, A, B, C]
+	options       runOptions
 }
 
 // Run execute the non-deterministic synchronous computation, with side effect.
-func (io IOEither3[R, A, B, C]) Run(a A, b B, c C) Either[error, R] {
+func (io IOEither3[R, A, B, C]) Run(a A, b B, c C) (either Either[error, R]) {
+	if io.options.recoverPanics {
+		defer recoverPanic(func(err error) {
+			either = Left[error, R](err)
+		})
+	}
+
 	v, err := io.unsafePerform(a, b, c)
 	if err != nil {
 		return Left[error, R](err)
@@ -93,9 +125,10 @@ func (io IOEither3[R, A, B, C]) Run(a A, b B, c C) Either[error, R] {
 }
 
 // NewIOEither4 instanciates a new IO4.
-func NewIOEither4[R any, A any, B any, C any, D any](f fe4[R, A, B, C, D]) IOEither4[R, A, B, C, D] {
+func NewIOEither4[R any, A any, B any, C any, D any](f fe4[R, A, B, C, D], opts ...RunOption) IOEither4[R, A, B, C, D] {
 	return IOEither4[R, A, B, C, D]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
@@ -103,10 +136,17 @@ func NewIOEither4[R any, A any, B any, C any, D any](f fe4[R, A, B, C, D]) IOEit
 // can cause side effects, yields a value of type `R` and can fail.
 type IOEither4[R any, A any, B any, C any, D any] struct {
 	unsafePerform fe4[R, A, B, C, D]
+	options       runOptions
 }
 
 // Run execute the non-deterministic synchronous computation, with side effect.
-func (io IOEither4[R, A, B, C, D]) Run(a A, b B, c C, d D) Either[error, R] {
+func (io IOEither4[R, A, B, C, D]) Run(a A, b B, c C, d D) (either Either[error, R]) {
+	if io.options.recoverPanics {
+		defer recoverPanic(func(err error) {
+			either = Left[error, R](err)
+		})
+	}
+
 	v, err := io.unsafePerform(a, b, c, d)
 	if err != nil {
 		return Left[error, R](err)
@@ -116,9 +156,10 @@ func (io IOEither4[R, A, B, C, D]) Run(a A, b B, c C, d D) Either[error, R] {
 }
 
 // NewIOEither5 instanciates a new IO5.
-func NewIOEither5[R any, A any, B any, C any, D any, E any](f fe5[R, A, B, C, D, E]) IOEither5[R, A, B, C, D, E] {
+func NewIOEither5[R any, A any, B any, C any, D any, E any](f fe5[R, A, B, C, D, E], opts ...RunOption) IOEither5[R, A, B, C, D, E] {
 	return IOEither5[R, A, B, C, D, E]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
@@ -126,10 +167,17 @@ func NewIOEither5[R any, A any, B any, C any, D any, E any](f fe5[R, A, B, C, D,
 // can cause side effects, yields a value of type `R` and can fail.
 type IOEither5[R any, A any, B any, C any, D any, E any] struct {
 	unsafePerform fe5[R, A, B, C, D, E]
+	options       runOptions
 }
 
 // Run execute the non-deterministic synchronous computation, with side effect.
-func (io IOEither5[R, A, B, C, D, E]) Run(a A, b B, c C, d D, e E) Either[error, R] {
+func (io IOEither5[R, A, B, C, D, E]) Run(a A, b B, c C, d D, e E) (either Either[error, R]) {
+	if io.options.recoverPanics {
+		defer recoverPanic(func(err error) {
+			either = Left[error, R](err)
+		})
+	}
+
 	v, err := io.unsafePerform(a, b, c, d, e)
 	if err != nil {
 		return Left[error, R](err)
@@ -0,0 +1,135 @@
+package mo
+
+// NewIOEitherFromResult instanciates a new IOEither yielding the value or the error of result.
+func NewIOEitherFromResult[R any](result Result[R]) IOEither[R] {
+	return NewIOEither(result.Get)
+}
+
+// NewIOEitherFromIO instanciates a new IOEither yielding the value of io. Unlike IO, it
+// recovers the panics of io into a *PanicError, unless disabled with WithPanicRecovery.
+func NewIOEitherFromIO[R any](io IO[R], opts ...RunOption) IOEither[R] {
+	return NewIOEither(func() (R, error) {
+		return io.Run(), nil
+	}, opts...)
+}
+
+// run executes io and returns its value or error.
+func (io IOEither[R]) run() (R, error) {
+	either := io.Run()
+	if either.IsLeft() {
+		return empty[R](), either.MustLeft()
+	}
+	return either.MustRight(), nil
+}
+
+// Map returns an IOEither applying f to the value of io. Nothing runs until Run is called.
+func (io IOEither[R]) Map(f func(R) R) IOEither[R] {
+	return MapIOEither(io, f)
+}
+
+// FlatMap returns an IOEither running the IOEither returned by f for the value of io.
+func (io IOEither[R]) FlatMap(f func(R) IOEither[R]) IOEither[R] {
+	return FlatMapIOEither(io, f)
+}
+
+// Tap returns an IOEither calling f with the value of io, then yielding that value.
+// f is not called when io fails.
+func (io IOEither[R]) Tap(f func(R)) IOEither[R] {
+	return MapIOEither(io, func(value R) R {
+		f(value)
+		return value
+	})
+}
+
+// MapError returns an IOEither applying f to the error of io. The error of io is kept when
+// f returns nil: MapError cannot turn a failure into a success, see OrElseIOEither.
+func (io IOEither[R]) MapError(f func(error) error) IOEither[R] {
+	return deriveIOEither(io, func() (R, error) {
+		value, err := io.run()
+		if err != nil {
+			if mapped := f(err); mapped != nil {
+				return empty[R](), mapped
+			}
+			return empty[R](), err
+		}
+		return value, nil
+	})
+}
+
+// MapIOEither returns an IOEither applying f to the value of io. Nothing runs until Run is called.
+// The IOEither runs with the options of io.
+func MapIOEither[A any, B any](io IOEither[A], f func(A) B) IOEither[B] {
+	return deriveIOEither(io, func() (B, error) {
+		value, err := io.run()
+		if err != nil {
+			return empty[B](), err
+		}
+		return f(value), nil
+	})
+}
+
+// FlatMapIOEither returns an IOEither running the IOEither returned by f for the value of io.
+// The IOEither runs with the options of io.
+func FlatMapIOEither[A any, B any](io IOEither[A], f func(A) IOEither[B]) IOEither[B] {
+	return deriveIOEither(io, func() (B, error) {
+		value, err := io.run()
+		if err != nil {
+			return empty[B](), err
+		}
+		return f(value).run()
+	})
+}
+
+// OrElseIOEither returns an IOEither running the IOEither returned by f for the error of io.
+// The IOEither runs with the options of io.
+func OrElseIOEither[R any](io IOEither[R], f func(error) IOEither[R]) IOEither[R] {
+	return deriveIOEither(io, func() (R, error) {
+		value, err := io.run()
+		if err != nil {
+			return f(err).run()
+		}
+		return value, nil
+	})
+}
+
+// deriveIOEither returns an IOEither with the options of io, running perform.
+func deriveIOEither[A any, B any](io IOEither[A], perform fe0[B]) IOEither[B] {
+	return IOEither[B]{
+		unsafePerform: perform,
+		options:       io.options,
+	}
+}
+
+// ZipIOEither returns an IOEither running a then b, and yielding both values.
+// It fails with the first error, and b is not run when a fails.
+func ZipIOEither[A any, B any](a IOEither[A], b IOEither[B]) IOEither[Tuple2[A, B]] {
+	return FlatMapIOEither(a, func(valueA A) IOEither[Tuple2[A, B]] {
+		return MapIOEither(b, func(valueB B) Tuple2[A, B] {
+			return T2(valueA, valueB)
+		})
+	})
+}
+
+// SequenceIOEither returns an IOEither running every IOEither in order, and yielding
+// their values. It stops at the first error.
+func SequenceIOEither[R any](ios []IOEither[R]) IOEither[[]R] {
+	return TraverseIOEither(ios, func(io IOEither[R]) IOEither[R] {
+		return io
+	})
+}
+
+// TraverseIOEither returns an IOEither running the IOEither returned by f for every
+// item in order, and yielding their values. It stops at the first error.
+func TraverseIOEither[A any, R any](items []A, f func(A) IOEither[R]) IOEither[[]R] {
+	return NewIOEither(func() ([]R, error) {
+		values := make([]R, 0, len(items))
+		for _, item := range items {
+			value, err := f(item).run()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	})
+}
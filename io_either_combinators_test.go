@@ -0,0 +1,121 @@
+package mo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIOEitherCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	var runs int
+	io := NewIOEither(func() (int, error) {
+		runs++
+		return 21, nil
+	})
+
+	var tapped []int
+	composed := io.
+		Map(func(value int) int {
+			return value * 2
+		}).
+		FlatMap(func(value int) IOEither[int] {
+			return NewIOEitherFromResult(Ok(value + 1))
+		}).
+		Tap(func(value int) {
+			tapped = append(tapped, value)
+		})
+	is.Equal(0, runs)
+
+	is.Equal(Right[error, int](43), composed.Run())
+	is.Equal(1, runs)
+	is.Equal([]int{43}, tapped)
+
+	failing := NewIOEitherFromResult(Err[int](assert.AnError)).
+		Map(func(value int) int {
+			is.Fail("should not enter here")
+			return value
+		}).
+		Tap(func(value int) {
+			is.Fail("should not enter here")
+		}).
+		MapError(func(err error) error {
+			return fmt.Errorf("wrapped: %w", err)
+		})
+
+	result := failing.Run()
+	is.True(result.IsLeft())
+	is.True(errors.Is(result.MustLeft(), assert.AnError))
+	is.Equal("wrapped: assert.AnError general error for testing", result.MustLeft().Error())
+}
+
+func TestIOEitherTypeChangingCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	ok := NewIOEitherFromResult(Ok(42))
+	ko := NewIOEitherFromResult(Err[int](assert.AnError))
+
+	is.Equal(Right[error, string]("42"), MapIOEither(ok, strconv.Itoa).Run())
+	is.Equal(Left[error, string](assert.AnError), MapIOEither(ko, strconv.Itoa).Run())
+
+	is.Equal(Right[error, string]("42"), FlatMapIOEither(ok, func(value int) IOEither[string] {
+		return NewIOEitherFromResult(Ok(strconv.Itoa(value)))
+	}).Run())
+
+	is.Equal(Right[error, int](42), OrElseIOEither(ok, func(err error) IOEither[int] {
+		is.Fail("should not enter here")
+		return ko
+	}).Run())
+	is.Equal(Right[error, int](1), OrElseIOEither(ko, func(err error) IOEither[int] {
+		is.Equal(assert.AnError, err)
+		return NewIOEitherFromResult(Ok(1))
+	}).Run())
+
+	is.Equal(Right[error, Tuple2[int, string]](T2(42, "foo")), ZipIOEither(ok, NewIOEitherFromResult(Ok("foo"))).Run())
+	is.Equal(Left[error, Tuple2[int, int]](assert.AnError), ZipIOEither(ko, NewIOEither(func() (int, error) {
+		is.Fail("should not enter here")
+		return 0, nil
+	})).Run())
+
+	is.Equal(Right[error, []int]([]int{42, 42}), SequenceIOEither([]IOEither[int]{ok, ok}).Run())
+
+	var runs int
+	is.Equal(Left[error, []int](assert.AnError), TraverseIOEither([]int{1, 2, 3}, func(value int) IOEither[int] {
+		return NewIOEither(func() (int, error) {
+			runs++
+			if value == 2 {
+				return 0, assert.AnError
+			}
+			return value, nil
+		})
+	}).Run())
+	is.Equal(2, runs)
+}
+
+func TestIOEitherCombinatorsOptions(t *testing.T) {
+	is := assert.New(t)
+
+	io := NewIOEither(func() (int, error) {
+		return 42, nil
+	}, WithPanicRecovery(false))
+
+	is.PanicsWithValue("boom", func() {
+		io.Map(func(value int) int {
+			panic("boom")
+		}).Run()
+	})
+	is.PanicsWithValue("boom", func() {
+		FlatMapIOEither(io, func(value int) IOEither[string] {
+			panic("boom")
+		}).Run()
+	})
+
+	// MapError cannot turn a failure into a success
+	is.Equal(Left[error, int](assert.AnError), NewIOEitherFromResult(Err[int](assert.AnError)).MapError(func(err error) error {
+		return nil
+	}).Run())
+}
@@ -0,0 +1,285 @@
+// Package jsonx provides encoding/json helpers for the types of `mo`.
+//
+// Marshal works like json.Marshal, but also omits the struct fields tagged `omitempty`
+// whose value has an `IsZero() bool` method returning true, such as `mo.None`. Newer Go
+// versions support the `omitzero` option doing so with json.Marshal. A non-nil pointer
+// to None is encoded as an explicit null.
+//
+// Unmarshal works like json.Unmarshal, but tells a missing field from an explicit null:
+// pointer fields are left nil when missing, and point to their zero value when null.
+// A `*mo.Option[T]` field is then nil when missing, None when null and Some otherwise,
+// as PATCH endpoints need.
+//
+// Nested structs and pointers to structs are handled recursively, while slices and maps
+// are left to encoding/json. Embedded structs are flattened, without the conflict
+// resolution rules of encoding/json.
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+type isZeroer interface {
+	IsZero() bool
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	isZeroerType    = reflect.TypeOf((*isZeroer)(nil)).Elem()
+)
+
+// Marshal returns the json encoding of v, omitting the `omitempty` fields whose IsZero
+// method returns true.
+func Marshal(v any) ([]byte, error) {
+	return marshalValue(reflect.ValueOf(v))
+}
+
+// Unmarshal parses the json-encoded data and stores the result in the value pointed to
+// by v. Pointer fields explicitly set to null point to their zero value instead of nil.
+func Unmarshal(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	restoreNulls(data, reflect.ValueOf(v))
+	return nil
+}
+
+// field is an exported field of a struct, with its json options.
+type field struct {
+	name      string
+	value     reflect.Value
+	omitEmpty bool
+	omitZero  bool
+	quoted    bool
+}
+
+// structFields returns the fields of the struct v, flattening embedded structs.
+func structFields(v reflect.Value) []field {
+	t := v.Type()
+	fields := make([]field, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+
+		tag := structField.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		value := v.Field(i)
+
+		if structField.Anonymous && name == "" {
+			embedded := value
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				fields = append(fields, structFields(embedded)...)
+				continue
+			}
+		}
+
+		if !structField.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+
+		fields = append(fields, field{
+			name:      name,
+			value:     value,
+			omitEmpty: hasOption(options, "omitempty"),
+			omitZero:  hasOption(options, "omitzero"),
+			quoted:    hasOption(options, "string"),
+		})
+	}
+
+	return fields
+}
+
+func hasOption(options string, option string) bool {
+	for options != "" {
+		var current string
+		current, options, _ = strings.Cut(options, ",")
+		if current == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isStruct returns true when v should be walked field by field, instead of being left to
+// encoding/json.
+func isStruct(t reflect.Type, method reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !t.Implements(method) && !reflect.PointerTo(t).Implements(method)
+}
+
+func marshalValue(v reflect.Value) ([]byte, error) {
+	if !v.IsValid() {
+		return []byte("null"), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() && !v.Type().Implements(marshalerType) {
+			return marshalValue(v.Elem())
+		}
+	case reflect.Struct:
+		if isStruct(v.Type(), marshalerType) {
+			return marshalStruct(v)
+		}
+	}
+
+	return json.Marshal(v.Interface())
+}
+
+func marshalStruct(v reflect.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	first := true
+	for _, f := range structFields(v) {
+		if isOmitted(f) {
+			continue
+		}
+
+		value, err := marshalValue(f.value)
+		if err != nil {
+			return nil, err
+		}
+		if f.quoted && isQuotable(f.value.Kind()) {
+			value, err = json.Marshal(string(value))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		name, err := json.Marshal(f.name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func isOmitted(f field) bool {
+	if f.omitEmpty && (isEmptyValue(f.value) || isZero(f.value)) {
+		return true
+	}
+	return f.omitZero && (f.value.IsZero() || isZero(f.value))
+}
+
+// isZero returns true when v has an IsZero method returning true. Pointers are only zero
+// when nil, so that a pointer to None is encoded as an explicit null.
+func isZero(v reflect.Value) bool {
+	if v.Kind() == reflect.Pointer || !v.Type().Implements(isZeroerType) {
+		return false
+	}
+	if v.Kind() == reflect.Interface && v.IsNil() {
+		return false
+	}
+	return v.Interface().(isZeroer).IsZero()
+}
+
+// isEmptyValue reports whether encoding/json considers v empty for `omitempty`.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+func isQuotable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// restoreNulls allocates the pointer fields of v set to null in data, after json.Unmarshal
+// left them nil.
+func restoreNulls(data []byte, v reflect.Value) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	if !isStruct(v.Type(), unmarshalerType) {
+		return
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		// not an object, json.Unmarshal has already validated data
+		return
+	}
+
+	for _, f := range structFields(v) {
+		value, ok := lookupField(object, f.name)
+		if !ok {
+			continue
+		}
+
+		if f.value.Kind() == reflect.Pointer && bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			if f.value.IsNil() && f.value.CanSet() {
+				f.value.Set(reflect.New(f.value.Type().Elem()))
+			}
+			continue
+		}
+
+		restoreNulls(value, f.value)
+	}
+}
+
+// lookupField returns the value of the key matching name, preferring an exact match like
+// encoding/json does.
+func lookupField(object map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	if value, ok := object[name]; ok {
+		return value, true
+	}
+	for key, value := range object {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,156 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo"
+)
+
+type testAddress struct {
+	City mo.Option[string] `json:"city,omitempty"`
+}
+
+type testBase struct {
+	ID int `json:"id"`
+}
+
+type testUser struct {
+	testBase
+	Name     mo.Option[string]  `json:"name,omitempty"`
+	Nickname mo.Option[string]  `json:"nickname"`
+	Age      mo.Option[int]     `json:"age,omitzero"`
+	Email    *mo.Option[string] `json:"email,omitempty"`
+	Address  *testAddress       `json:"address,omitempty"`
+	Count    int                `json:"count,string"`
+	Tags     []string           `json:"tags,omitempty"`
+	Created  time.Time          `json:"created,omitempty"`
+	Ignored  string             `json:"-"`
+	secret   string
+}
+
+func TestMarshal(t *testing.T) {
+	is := assert.New(t)
+
+	value, err := Marshal(testUser{
+		testBase: testBase{ID: 1},
+		Address:  &testAddress{},
+		Count:    2,
+		Ignored:  "ignored",
+		secret:   "secret",
+	})
+	is.NoError(err)
+	is.Equal(`{"id":1,"nickname":null,"address":{},"count":"2"}`, string(value))
+
+	email := mo.None[string]()
+	value, err = Marshal(&testUser{
+		Name:    mo.Some("alice"),
+		Age:     mo.Some(42),
+		Email:   &email,
+		Address: &testAddress{City: mo.Some("Paris")},
+		Tags:    []string{"admin"},
+		Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	is.NoError(err)
+	is.Equal(`{"id":0,"name":"alice","nickname":null,"age":42,"email":null,"address":{"city":"Paris"},"count":"0","tags":["admin"],"created":"2024-01-02T03:04:05Z"}`, string(value))
+
+	value, err = Marshal([]int{1, 2})
+	is.NoError(err)
+	is.Equal(`[1,2]`, string(value))
+
+	value, err = Marshal(nil)
+	is.NoError(err)
+	is.Equal(`null`, string(value))
+
+	_, err = Marshal(struct {
+		Channel chan int `json:"channel"`
+	}{})
+	is.Error(err)
+}
+
+func TestMarshalMatchesEncodingJSON(t *testing.T) {
+	is := assert.New(t)
+
+	type testStruct struct {
+		A string            `json:"a"`
+		B int               `json:"b,omitempty"`
+		C mo.Option[string] `json:"c"`
+		D map[string]int    `json:"d"`
+		E any               `json:"e"`
+		F float64
+	}
+
+	for _, v := range []testStruct{
+		{},
+		{A: "a", B: 1, C: mo.Some("c"), D: map[string]int{"x": 1}, E: []int{1}, F: 1.5},
+	} {
+		expected, err := json.Marshal(v)
+		is.NoError(err)
+
+		value, err := Marshal(v)
+		is.NoError(err)
+		is.JSONEq(string(expected), string(value))
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	is := assert.New(t)
+
+	var user testUser
+	is.NoError(Unmarshal([]byte(`{"id":1,"name":"alice","count":"2"}`), &user))
+	is.Equal(1, user.ID)
+	is.Equal(mo.Some("alice"), user.Name)
+	is.Equal(mo.None[string](), user.Nickname)
+	is.Nil(user.Email)
+	is.Nil(user.Address)
+	is.Equal(2, user.Count)
+
+	user = testUser{}
+	is.NoError(Unmarshal([]byte(`{"email":null,"address":null}`), &user))
+	is.NotNil(user.Email)
+	is.Equal(mo.None[string](), *user.Email)
+	is.Equal(&testAddress{}, user.Address)
+
+	user = testUser{}
+	is.NoError(Unmarshal([]byte(`{"EMAIL":"alice@example.com"}`), &user))
+	is.Equal(mo.Some("alice@example.com"), *user.Email)
+
+	type testPatch struct {
+		User *testUser `json:"user"`
+	}
+
+	var patch testPatch
+	is.NoError(Unmarshal([]byte(`{"user":{"email":null}}`), &patch))
+	is.Equal(mo.None[string](), *patch.User.Email)
+
+	patch = testPatch{}
+	is.NoError(Unmarshal([]byte(`{"user":null}`), &patch))
+	is.Equal(&testUser{}, patch.User)
+
+	var values []int
+	is.NoError(Unmarshal([]byte(`[1,2]`), &values))
+	is.Equal([]int{1, 2}, values)
+
+	is.Error(Unmarshal([]byte(`{"id":"foo"}`), &user))
+	is.Error(Unmarshal([]byte(`{`), &user))
+}
+
+func TestNullable(t *testing.T) {
+	is := assert.New(t)
+
+	type testPatch struct {
+		Name     mo.Nullable[string] `json:"name,omitempty"`
+		Nickname mo.Nullable[string] `json:"nickname,omitempty"`
+		Email    mo.Nullable[string] `json:"email,omitempty"`
+	}
+
+	value, err := Marshal(testPatch{Name: mo.NullableValue("alice"), Nickname: mo.NullableNull[string]()})
+	is.NoError(err)
+	is.Equal(`{"name":"alice","nickname":null}`, string(value))
+
+	var patch testPatch
+	is.NoError(Unmarshal(value, &patch))
+	is.Equal(testPatch{Name: mo.NullableValue("alice"), Nickname: mo.NullableNull[string]()}, patch)
+}
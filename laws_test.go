@@ -0,0 +1,653 @@
+package mo
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/lawtest"
+)
+
+// This file checks the monad laws of every type of the library, through minimal
+// typeclass instances built on top of their public methods.
+
+var lawErrors = []error{errors.New("foo"), errors.New("bar")}
+
+func genLawInt(r *rand.Rand) int {
+	return r.Intn(200) - 100
+}
+
+func genLawError(r *rand.Rand) error {
+	return lawErrors[r.Intn(len(lawErrors))]
+}
+
+func genLawFunc(r *rand.Rand) func(int) int {
+	n := genLawInt(r)
+	switch r.Intn(3) {
+	case 0:
+		return func(i int) int { return i + n }
+	case 1:
+		return func(i int) int { return i * n }
+	default:
+		return func(i int) int { return n }
+	}
+}
+
+// genLawKleisli builds a function `A -> F[A]` which fails on some inputs.
+func genLawKleisli[FA any](r *rand.Rand, ok func(int) FA, ko func(error) FA) func(int) FA {
+	n := genLawInt(r)
+	err := genLawError(r)
+	return func(i int) FA {
+		if (i+n)%3 == 0 {
+			return ko(err)
+		}
+		return ok(i - n)
+	}
+}
+
+type optionLawInstance[A any] struct{}
+
+func (optionLawInstance[A]) Of(a A) Option[A] {
+	return Some(a)
+}
+
+func (optionLawInstance[A]) Map(fa Option[A], f func(A) A) Option[A] {
+	return fa.Map(func(value A) (A, bool) {
+		return f(value), true
+	})
+}
+
+func (i optionLawInstance[A]) Ap(fab Option[func(A) A], fa Option[A]) Option[A] {
+	if f, ok := fab.Get(); ok {
+		return i.Map(fa, f)
+	}
+	return None[A]()
+}
+
+func (optionLawInstance[A]) FlatMap(fa Option[A], f func(A) Option[A]) Option[A] {
+	return fa.FlatMap(f)
+}
+
+func TestOptionLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Option[int], Option[func(int) int]]{
+		Instance: optionLawInstance[int]{},
+		OfFunc:   optionLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) Option[int] {
+			if r.Intn(4) == 0 {
+				return None[int]()
+			}
+			return Some(genLawInt(r))
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) Option[int] {
+			return genLawKleisli(r, Some[int], func(error) Option[int] { return None[int]() })
+		},
+		Equal: lawtest.Comparable[Option[int]](),
+	})
+}
+
+type resultLawInstance[A any] struct{}
+
+func (resultLawInstance[A]) Of(a A) Result[A] {
+	return Ok(a)
+}
+
+func (resultLawInstance[A]) Map(fa Result[A], f func(A) A) Result[A] {
+	return fa.Map(func(value A) (A, error) {
+		return f(value), nil
+	})
+}
+
+func (i resultLawInstance[A]) Ap(fab Result[func(A) A], fa Result[A]) Result[A] {
+	if fab.IsError() {
+		return Err[A](fab.Error())
+	}
+	return i.Map(fa, fab.MustGet())
+}
+
+func (resultLawInstance[A]) FlatMap(fa Result[A], f func(A) Result[A]) Result[A] {
+	return fa.FlatMap(f)
+}
+
+func TestResultLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Result[int], Result[func(int) int]]{
+		Instance: resultLawInstance[int]{},
+		OfFunc:   resultLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) Result[int] {
+			if r.Intn(4) == 0 {
+				return Err[int](genLawError(r))
+			}
+			return Ok(genLawInt(r))
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) Result[int] {
+			return genLawKleisli(r, Ok[int], Err[int])
+		},
+		Equal: lawtest.DeepEqual[Result[int]](),
+	})
+}
+
+type eitherLawInstance[A any] struct{}
+
+func (eitherLawInstance[A]) Of(a A) Either[error, A] {
+	return Right[error](a)
+}
+
+func (eitherLawInstance[A]) Map(fa Either[error, A], f func(A) A) Either[error, A] {
+	return fa.MapRight(func(value A) Either[error, A] {
+		return Right[error](f(value))
+	})
+}
+
+func (i eitherLawInstance[A]) Ap(fab Either[error, func(A) A], fa Either[error, A]) Either[error, A] {
+	if fab.IsLeft() {
+		return Left[error, A](fab.MustLeft())
+	}
+	return i.Map(fa, fab.MustRight())
+}
+
+func (eitherLawInstance[A]) FlatMap(fa Either[error, A], f func(A) Either[error, A]) Either[error, A] {
+	return fa.MapRight(f)
+}
+
+func TestEitherLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Either[error, int], Either[error, func(int) int]]{
+		Instance: eitherLawInstance[int]{},
+		OfFunc:   eitherLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) Either[error, int] {
+			if r.Intn(4) == 0 {
+				return Left[error, int](genLawError(r))
+			}
+			return Right[error](genLawInt(r))
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) Either[error, int] {
+			return genLawKleisli(r, Right[error, int], Left[error, int])
+		},
+		Equal: lawtest.DeepEqual[Either[error, int]](),
+	})
+}
+
+// EitherX instances are biased on their last argument.
+
+type either3LawInstance[A any] struct{}
+
+func (either3LawInstance[A]) Of(a A) Either3[string, bool, A] {
+	return NewEither3Arg3[string, bool](a)
+}
+
+func (either3LawInstance[A]) Map(fa Either3[string, bool, A], f func(A) A) Either3[string, bool, A] {
+	return fa.MapArg3(func(value A) Either3[string, bool, A] {
+		return NewEither3Arg3[string, bool](f(value))
+	})
+}
+
+func (i either3LawInstance[A]) Ap(fab Either3[string, bool, func(A) A], fa Either3[string, bool, A]) Either3[string, bool, A] {
+	if v, ok := fab.Arg1(); ok {
+		return NewEither3Arg1[string, bool, A](v)
+	}
+	if v, ok := fab.Arg2(); ok {
+		return NewEither3Arg2[string, bool, A](v)
+	}
+	return i.Map(fa, fab.MustArg3())
+}
+
+func (either3LawInstance[A]) FlatMap(fa Either3[string, bool, A], f func(A) Either3[string, bool, A]) Either3[string, bool, A] {
+	return fa.MapArg3(f)
+}
+
+type either4LawInstance[A any] struct{}
+
+func (either4LawInstance[A]) Of(a A) Either4[string, bool, float64, A] {
+	return NewEither4Arg4[string, bool, float64](a)
+}
+
+func (either4LawInstance[A]) Map(fa Either4[string, bool, float64, A], f func(A) A) Either4[string, bool, float64, A] {
+	return fa.MapArg4(func(value A) Either4[string, bool, float64, A] {
+		return NewEither4Arg4[string, bool, float64](f(value))
+	})
+}
+
+func (i either4LawInstance[A]) Ap(fab Either4[string, bool, float64, func(A) A], fa Either4[string, bool, float64, A]) Either4[string, bool, float64, A] {
+	if v, ok := fab.Arg1(); ok {
+		return NewEither4Arg1[string, bool, float64, A](v)
+	}
+	if v, ok := fab.Arg2(); ok {
+		return NewEither4Arg2[string, bool, float64, A](v)
+	}
+	if v, ok := fab.Arg3(); ok {
+		return NewEither4Arg3[string, bool, float64, A](v)
+	}
+	return i.Map(fa, fab.MustArg4())
+}
+
+func (either4LawInstance[A]) FlatMap(fa Either4[string, bool, float64, A], f func(A) Either4[string, bool, float64, A]) Either4[string, bool, float64, A] {
+	return fa.MapArg4(f)
+}
+
+type either5LawInstance[A any] struct{}
+
+func (either5LawInstance[A]) Of(a A) Either5[string, bool, float64, byte, A] {
+	return NewEither5Arg5[string, bool, float64, byte](a)
+}
+
+func (either5LawInstance[A]) Map(fa Either5[string, bool, float64, byte, A], f func(A) A) Either5[string, bool, float64, byte, A] {
+	return fa.MapArg5(func(value A) Either5[string, bool, float64, byte, A] {
+		return NewEither5Arg5[string, bool, float64, byte](f(value))
+	})
+}
+
+func (i either5LawInstance[A]) Ap(fab Either5[string, bool, float64, byte, func(A) A], fa Either5[string, bool, float64, byte, A]) Either5[string, bool, float64, byte, A] {
+	if v, ok := fab.Arg1(); ok {
+		return NewEither5Arg1[string, bool, float64, byte, A](v)
+	}
+	if v, ok := fab.Arg2(); ok {
+		return NewEither5Arg2[string, bool, float64, byte, A](v)
+	}
+	if v, ok := fab.Arg3(); ok {
+		return NewEither5Arg3[string, bool, float64, byte, A](v)
+	}
+	if v, ok := fab.Arg4(); ok {
+		return NewEither5Arg4[string, bool, float64, byte, A](v)
+	}
+	return i.Map(fa, fab.MustArg5())
+}
+
+func (either5LawInstance[A]) FlatMap(fa Either5[string, bool, float64, byte, A], f func(A) Either5[string, bool, float64, byte, A]) Either5[string, bool, float64, byte, A] {
+	return fa.MapArg5(f)
+}
+
+func TestEitherXLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Either3[string, bool, int], Either3[string, bool, func(int) int]]{
+		Instance: either3LawInstance[int]{},
+		OfFunc:   either3LawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) Either3[string, bool, int] {
+			switch r.Intn(4) {
+			case 0:
+				return NewEither3Arg1[string, bool, int]("foo")
+			case 1:
+				return NewEither3Arg2[string, bool, int](true)
+			default:
+				return NewEither3Arg3[string, bool](genLawInt(r))
+			}
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) Either3[string, bool, int] {
+			return genLawKleisli(r, NewEither3Arg3[string, bool, int], func(err error) Either3[string, bool, int] {
+				return NewEither3Arg1[string, bool, int](err.Error())
+			})
+		},
+		Equal: lawtest.Comparable[Either3[string, bool, int]](),
+	})
+
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Either4[string, bool, float64, int], Either4[string, bool, float64, func(int) int]]{
+		Instance: either4LawInstance[int]{},
+		OfFunc:   either4LawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) Either4[string, bool, float64, int] {
+			switch r.Intn(5) {
+			case 0:
+				return NewEither4Arg1[string, bool, float64, int]("foo")
+			case 1:
+				return NewEither4Arg2[string, bool, float64, int](true)
+			case 2:
+				return NewEither4Arg3[string, bool, float64, int](4.2)
+			default:
+				return NewEither4Arg4[string, bool, float64](genLawInt(r))
+			}
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) Either4[string, bool, float64, int] {
+			return genLawKleisli(r, NewEither4Arg4[string, bool, float64, int], func(err error) Either4[string, bool, float64, int] {
+				return NewEither4Arg1[string, bool, float64, int](err.Error())
+			})
+		},
+		Equal: lawtest.Comparable[Either4[string, bool, float64, int]](),
+	})
+
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Either5[string, bool, float64, byte, int], Either5[string, bool, float64, byte, func(int) int]]{
+		Instance: either5LawInstance[int]{},
+		OfFunc:   either5LawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) Either5[string, bool, float64, byte, int] {
+			switch r.Intn(6) {
+			case 0:
+				return NewEither5Arg1[string, bool, float64, byte, int]("foo")
+			case 1:
+				return NewEither5Arg2[string, bool, float64, byte, int](true)
+			case 2:
+				return NewEither5Arg3[string, bool, float64, byte, int](4.2)
+			case 3:
+				return NewEither5Arg4[string, bool, float64, byte, int](42)
+			default:
+				return NewEither5Arg5[string, bool, float64, byte](genLawInt(r))
+			}
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) Either5[string, bool, float64, byte, int] {
+			return genLawKleisli(r, NewEither5Arg5[string, bool, float64, byte, int], func(err error) Either5[string, bool, float64, byte, int] {
+				return NewEither5Arg1[string, bool, float64, byte, int](err.Error())
+			})
+		},
+		Equal: lawtest.Comparable[Either5[string, bool, float64, byte, int]](),
+	})
+}
+
+type futureLawInstance[A any] struct{}
+
+func (futureLawInstance[A]) Of(a A) *Future[A] {
+	return NewFuture(func(resolve func(A), reject func(error)) {
+		resolve(a)
+	})
+}
+
+func (futureLawInstance[A]) Map(fa *Future[A], f func(A) A) *Future[A] {
+	return fa.Then(func(value A) (A, error) {
+		return f(value), nil
+	})
+}
+
+func (futureLawInstance[A]) Ap(fab *Future[func(A) A], fa *Future[A]) *Future[A] {
+	return NewFuture(func(resolve func(A), reject func(error)) {
+		f, err := fab.Collect()
+		if err != nil {
+			reject(err)
+			return
+		}
+		value, err := fa.Collect()
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(f(value))
+	})
+}
+
+func (futureLawInstance[A]) FlatMap(fa *Future[A], f func(A) *Future[A]) *Future[A] {
+	return fa.Then(func(value A) (A, error) {
+		return f(value).Collect()
+	})
+}
+
+func genLawFuture(r *rand.Rand) *Future[int] {
+	value, err := genLawInt(r), genLawError(r)
+	failed := r.Intn(4) == 0
+
+	future := NewFuture(func(resolve func(int), reject func(error)) {
+		if failed {
+			reject(err)
+		} else {
+			resolve(value)
+		}
+	})
+	// settle the future, so that it can be chained several times
+	_, _ = future.Collect()
+
+	return future
+}
+
+func futureLawEqual(x *Future[int], y *Future[int]) bool {
+	return x.Result() == y.Result()
+}
+
+func TestFutureLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, *Future[int], *Future[func(int) int]]{
+		Config:   lawtest.Config{Iterations: 20},
+		Instance: futureLawInstance[int]{},
+		OfFunc:   futureLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA:    genLawFuture,
+		GenFunc:  genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) *Future[int] {
+			return genLawKleisli(r,
+				futureLawInstance[int]{}.Of,
+				func(err error) *Future[int] {
+					return NewFuture(func(resolve func(int), reject func(error)) {
+						reject(err)
+					})
+				},
+			)
+		},
+		Equal: futureLawEqual,
+	})
+}
+
+// applyLawTuple applies the function of the tuple to its value, to build Ap from Zip.
+func applyLawTuple[A any](t Tuple2[func(A) A, A]) A {
+	return t.A(t.B)
+}
+
+type ioLawInstance[A any] struct{}
+
+func (ioLawInstance[A]) Of(a A) IO[A] {
+	return NewIO(func() A {
+		return a
+	})
+}
+
+func (ioLawInstance[A]) Map(fa IO[A], f func(A) A) IO[A] {
+	return MapIO(fa, f)
+}
+
+func (ioLawInstance[A]) Ap(fab IO[func(A) A], fa IO[A]) IO[A] {
+	return MapIO(ZipIO(fab, fa), applyLawTuple[A])
+}
+
+func (ioLawInstance[A]) FlatMap(fa IO[A], f func(A) IO[A]) IO[A] {
+	return FlatMapIO(fa, f)
+}
+
+func TestIOLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, IO[int], IO[func(int) int]]{
+		Instance: ioLawInstance[int]{},
+		OfFunc:   ioLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) IO[int] {
+			return ioLawInstance[int]{}.Of(genLawInt(r))
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) IO[int] {
+			f := genLawFunc(r)
+			return func(i int) IO[int] {
+				return ioLawInstance[int]{}.Of(f(i))
+			}
+		},
+		Equal: func(x IO[int], y IO[int]) bool {
+			return x.Run() == y.Run()
+		},
+	})
+}
+
+type ioEitherLawInstance[A any] struct{}
+
+func (ioEitherLawInstance[A]) Of(a A) IOEither[A] {
+	return NewIOEitherFromResult(Ok(a))
+}
+
+func (ioEitherLawInstance[A]) Map(fa IOEither[A], f func(A) A) IOEither[A] {
+	return MapIOEither(fa, f)
+}
+
+func (ioEitherLawInstance[A]) Ap(fab IOEither[func(A) A], fa IOEither[A]) IOEither[A] {
+	return MapIOEither(ZipIOEither(fab, fa), applyLawTuple[A])
+}
+
+func (ioEitherLawInstance[A]) FlatMap(fa IOEither[A], f func(A) IOEither[A]) IOEither[A] {
+	return FlatMapIOEither(fa, f)
+}
+
+func genLawIOEither(r *rand.Rand) IOEither[int] {
+	if r.Intn(4) == 0 {
+		return NewIOEitherFromResult(Err[int](genLawError(r)))
+	}
+	return ioEitherLawInstance[int]{}.Of(genLawInt(r))
+}
+
+func TestIOEitherLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, IOEither[int], IOEither[func(int) int]]{
+		Instance: ioEitherLawInstance[int]{},
+		OfFunc:   ioEitherLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA:    genLawIOEither,
+		GenFunc:  genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) IOEither[int] {
+			return genLawKleisli(r,
+				ioEitherLawInstance[int]{}.Of,
+				func(err error) IOEither[int] {
+					return NewIOEitherFromResult(Err[int](err))
+				},
+			)
+		},
+		Equal: func(x IOEither[int], y IOEither[int]) bool {
+			return x.Run() == y.Run()
+		},
+	})
+}
+
+type taskLawInstance[A any] struct{}
+
+func (taskLawInstance[A]) Of(a A) Task[A] {
+	return NewTask(func() *Future[A] {
+		return futureLawInstance[A]{}.Of(a)
+	})
+}
+
+func (taskLawInstance[A]) Map(fa Task[A], f func(A) A) Task[A] {
+	return MapTask(fa, f)
+}
+
+func (taskLawInstance[A]) Ap(fab Task[func(A) A], fa Task[A]) Task[A] {
+	return MapTask(ZipTask(fab, fa), applyLawTuple[A])
+}
+
+func (taskLawInstance[A]) FlatMap(fa Task[A], f func(A) Task[A]) Task[A] {
+	return FlatMapTask(fa, f)
+}
+
+func TestTaskLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Task[int], Task[func(int) int]]{
+		Config:   lawtest.Config{Iterations: 20},
+		Instance: taskLawInstance[int]{},
+		OfFunc:   taskLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) Task[int] {
+			return taskLawInstance[int]{}.Of(genLawInt(r))
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) Task[int] {
+			f := genLawFunc(r)
+			return func(i int) Task[int] {
+				return taskLawInstance[int]{}.Of(f(i))
+			}
+		},
+		Equal: func(x Task[int], y Task[int]) bool {
+			return x.Run().Result() == y.Run().Result()
+		},
+	})
+}
+
+type taskEitherLawInstance[A any] struct{}
+
+func (taskEitherLawInstance[A]) Of(a A) TaskEither[A] {
+	return NewTaskEitherFromResult(Ok(a))
+}
+
+func (taskEitherLawInstance[A]) Map(fa TaskEither[A], f func(A) A) TaskEither[A] {
+	return MapTaskEither(fa, f)
+}
+
+func (taskEitherLawInstance[A]) Ap(fab TaskEither[func(A) A], fa TaskEither[A]) TaskEither[A] {
+	return MapTaskEither(ZipTaskEither(fab, fa), applyLawTuple[A])
+}
+
+func (taskEitherLawInstance[A]) FlatMap(fa TaskEither[A], f func(A) TaskEither[A]) TaskEither[A] {
+	return FlatMapTaskEither(fa, f)
+}
+
+func TestTaskEitherLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, TaskEither[int], TaskEither[func(int) int]]{
+		Config:   lawtest.Config{Iterations: 20},
+		Instance: taskEitherLawInstance[int]{},
+		OfFunc:   taskEitherLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA: func(r *rand.Rand) TaskEither[int] {
+			if r.Intn(4) == 0 {
+				return NewTaskEitherFromResult(Err[int](genLawError(r)))
+			}
+			return taskEitherLawInstance[int]{}.Of(genLawInt(r))
+		},
+		GenFunc: genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) TaskEither[int] {
+			return genLawKleisli(r,
+				taskEitherLawInstance[int]{}.Of,
+				func(err error) TaskEither[int] {
+					return NewTaskEitherFromResult(Err[int](err))
+				},
+			)
+		},
+		Equal: func(x TaskEither[int], y TaskEither[int]) bool {
+			return x.Run().Result() == y.Run().Result()
+		},
+	})
+}
+
+type stateLawInstance[A any] struct{}
+
+func (stateLawInstance[A]) Of(a A) State[int, A] {
+	return ReturnState[int](a)
+}
+
+func (stateLawInstance[A]) Map(fa State[int, A], f func(A) A) State[int, A] {
+	return fa.Map(f)
+}
+
+func (stateLawInstance[A]) Ap(fab State[int, func(A) A], fa State[int, A]) State[int, A] {
+	return NewState(func(state int) (A, int) {
+		f, state := fab.Run(state)
+		a, state := fa.Run(state)
+		return f(a), state
+	})
+}
+
+func (stateLawInstance[A]) FlatMap(fa State[int, A], f func(A) State[int, A]) State[int, A] {
+	return fa.FlatMap(f)
+}
+
+func genLawState(r *rand.Rand) State[int, int] {
+	f, g := genLawFunc(r), genLawFunc(r)
+	return NewState(func(state int) (int, int) {
+		return f(state), g(state)
+	})
+}
+
+func TestStateLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, State[int, int], State[int, func(int) int]]{
+		Instance: stateLawInstance[int]{},
+		OfFunc:   stateLawInstance[func(int) int]{},
+		GenA:     genLawInt,
+		GenFA:    genLawState,
+		GenFunc:  genLawFunc,
+		GenKleisli: func(r *rand.Rand) func(int) State[int, int] {
+			n := genLawInt(r)
+			return func(i int) State[int, int] {
+				return NewState(func(state int) (int, int) {
+					return i + state, state*n + i
+				})
+			}
+		},
+		Equal: func(x State[int, int], y State[int, int]) bool {
+			for _, state := range []int{-1, 0, 42} {
+				a1, s1 := x.Run(state)
+				a2, s2 := y.Run(state)
+				if a1 != a2 || s1 != s2 {
+					return false
+				}
+			}
+			return true
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package lawtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/typeclass"
+)
+
+// ApplicativeLaws describes how to check an Applicative instance.
+// OfFunc lifts functions into the applicative, in order to build the `F[A -> A]` (spelled FAA)
+// values expected by Ap.
+type ApplicativeLaws[A any, FA any, FAA any] struct {
+	Config
+
+	Instance typeclass.Applicative[A, A, FA, FA, FAA]
+	OfFunc   typeclass.Pointed[func(A) A, FAA]
+	GenA     Gen[A]
+	GenFA    Gen[FA]
+	GenFunc  Gen[func(A) A]
+	Equal    Eq[FA]
+}
+
+// Applicative checks the functor laws, then the identity, homomorphism and
+// map consistency laws of an Applicative.
+func Applicative[A any, FA any, FAA any](t testing.TB, laws ApplicativeLaws[A, FA, FAA]) {
+	t.Helper()
+
+	Functor(t, FunctorLaws[A, FA]{
+		Config:   laws.Config,
+		Instance: laws.Instance,
+		GenFA:    laws.GenFA,
+		GenFunc:  laws.GenFunc,
+		Equal:    laws.Equal,
+	})
+
+	m := laws.Instance
+
+	// ap(of(id), fa) == fa
+	check(t, laws.Config, "applicative identity", func(r *rand.Rand) (bool, any, any) {
+		fa := laws.GenFA(r)
+		got := m.Ap(laws.OfFunc.Of(func(a A) A { return a }), fa)
+		return laws.Equal(got, fa), got, fa
+	})
+
+	// ap(of(f), of(a)) == of(f(a))
+	check(t, laws.Config, "applicative homomorphism", func(r *rand.Rand) (bool, any, any) {
+		a, f := laws.GenA(r), laws.GenFunc(r)
+		got := m.Ap(laws.OfFunc.Of(f), m.Of(a))
+		want := m.Of(f(a))
+		return laws.Equal(got, want), got, want
+	})
+
+	// map(fa, f) == ap(of(f), fa)
+	check(t, laws.Config, "applicative map consistency", func(r *rand.Rand) (bool, any, any) {
+		fa, f := laws.GenFA(r), laws.GenFunc(r)
+		got := m.Map(fa, f)
+		want := m.Ap(laws.OfFunc.Of(f), fa)
+		return laws.Equal(got, want), got, want
+	})
+}
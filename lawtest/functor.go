@@ -0,0 +1,40 @@
+package lawtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/typeclass"
+)
+
+// FunctorLaws describes how to check a Functor instance.
+type FunctorLaws[A any, FA any] struct {
+	Config
+
+	Instance typeclass.Functor[A, A, FA, FA]
+	GenFA    Gen[FA]
+	GenFunc  Gen[func(A) A]
+	Equal    Eq[FA]
+}
+
+// Functor checks the identity and composition laws of a Functor.
+func Functor[A any, FA any](t testing.TB, laws FunctorLaws[A, FA]) {
+	t.Helper()
+
+	m := laws.Instance
+
+	// map(fa, id) == fa
+	check(t, laws.Config, "functor identity", func(r *rand.Rand) (bool, any, any) {
+		fa := laws.GenFA(r)
+		got := m.Map(fa, func(a A) A { return a })
+		return laws.Equal(got, fa), got, fa
+	})
+
+	// map(fa, g . f) == map(map(fa, f), g)
+	check(t, laws.Config, "functor composition", func(r *rand.Rand) (bool, any, any) {
+		fa, f, g := laws.GenFA(r), laws.GenFunc(r), laws.GenFunc(r)
+		got := m.Map(fa, func(a A) A { return g(f(a)) })
+		want := m.Map(m.Map(fa, f), g)
+		return laws.Equal(got, want), got, want
+	})
+}
@@ -0,0 +1,74 @@
+// Package lawtest checks that typeclass instances obey the laws of their typeclass.
+//
+// Each check receives generators of random values and an equality function, then
+// verifies the laws against many generated samples. Go generics have no higher-kinded
+// types, so laws are checked with endomorphisms (`A -> A`): this is enough to catch
+// instances that drop values, run effects twice or reorder computations.
+package lawtest
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// DefaultIterations is the number of samples generated per law when Config.Iterations is zero.
+const DefaultIterations = 100
+
+// Gen builds a random value of type T.
+type Gen[T any] func(r *rand.Rand) T
+
+// Eq reports whether two values are equivalent.
+type Eq[T any] func(x T, y T) bool
+
+// Config holds the settings shared by all law checks.
+type Config struct {
+	// Iterations is the number of samples generated per law. Defaults to DefaultIterations.
+	Iterations int
+	// Seed of the random generator. A time-based seed is used when zero.
+	Seed int64
+}
+
+func (c Config) iterations() int {
+	if c.Iterations <= 0 {
+		return DefaultIterations
+	}
+	return c.Iterations
+}
+
+func (c Config) seed() int64 {
+	if c.Seed == 0 {
+		return time.Now().UnixNano()
+	}
+	return c.Seed
+}
+
+// check runs law against generated samples until it fails or iterations are exhausted.
+func check(t testing.TB, config Config, name string, law func(r *rand.Rand) (bool, any, any)) {
+	t.Helper()
+
+	seed := config.seed()
+	r := rand.New(rand.NewSource(seed))
+
+	for i := 0; i < config.iterations(); i++ {
+		if ok, got, want := law(r); !ok {
+			t.Errorf("%s law failed (seed %d, iteration %d):\n  got:  %v\n  want: %v", name, seed, i, got, want)
+			return
+		}
+	}
+}
+
+// Comparable returns an Eq relying on the == operator.
+func Comparable[T comparable]() Eq[T] {
+	return func(x T, y T) bool {
+		return x == y
+	}
+}
+
+// DeepEqual returns an Eq relying on reflect.DeepEqual.
+func DeepEqual[T any]() Eq[T] {
+	return func(x T, y T) bool {
+		return reflect.DeepEqual(x, y)
+	}
+}
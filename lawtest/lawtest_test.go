@@ -0,0 +1,127 @@
+package lawtest
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+// recorder captures failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+type box[A any] struct {
+	value A
+}
+
+type boxMonad[A any] struct {
+	brokenMap bool
+}
+
+func (boxMonad[A]) Of(a A) box[A] {
+	return box[A]{value: a}
+}
+
+func (m boxMonad[A]) Map(fa box[A], f func(A) A) box[A] {
+	if m.brokenMap {
+		return fa
+	}
+	return box[A]{value: f(fa.value)}
+}
+
+func (boxMonad[A]) Ap(fab box[func(A) A], fa box[A]) box[A] {
+	return box[A]{value: fab.value(fa.value)}
+}
+
+func (boxMonad[A]) FlatMap(fa box[A], f func(A) box[A]) box[A] {
+	return f(fa.value)
+}
+
+type boxPointed[A any] struct{}
+
+func (boxPointed[A]) Of(a A) A {
+	return a
+}
+
+func genInt(r *rand.Rand) int {
+	return r.Intn(1000) - 500
+}
+
+func boxLaws(m boxMonad[int]) MonadLaws[int, box[int], box[func(int) int]] {
+	return MonadLaws[int, box[int], box[func(int) int]]{
+		Config:   Config{Seed: 42},
+		Instance: m,
+		OfFunc:   typeclass.Pointed[func(int) int, box[func(int) int]](boxMonad[func(int) int]{}),
+		GenA:     genInt,
+		GenFA: func(r *rand.Rand) box[int] {
+			return box[int]{value: genInt(r)}
+		},
+		GenFunc: func(r *rand.Rand) func(int) int {
+			n := genInt(r)
+			return func(i int) int { return i + n }
+		},
+		GenKleisli: func(r *rand.Rand) func(int) box[int] {
+			n := genInt(r)
+			return func(i int) box[int] { return box[int]{value: i * n} }
+		},
+		Equal: Comparable[box[int]](),
+	}
+}
+
+func TestMonad(t *testing.T) {
+	is := assert.New(t)
+
+	rec := &recorder{TB: t}
+	Monad[int](rec, boxLaws(boxMonad[int]{}))
+	is.Empty(rec.failures)
+
+	rec = &recorder{TB: t}
+	Monad[int](rec, boxLaws(boxMonad[int]{brokenMap: true}))
+	is.Len(rec.failures, 1)
+	is.Contains(rec.failures[0], "applicative map consistency law failed (seed 42")
+}
+
+func TestMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	rec := &recorder{TB: t}
+	Monoid(rec, MonoidLaws[int]{
+		Instance: typeclass.SumMonoid[int](),
+		Gen:      genInt,
+		Equal:    Comparable[int](),
+	})
+	is.Empty(rec.failures)
+
+	rec = &recorder{TB: t}
+	Monoid(rec, MonoidLaws[int]{
+		Config: Config{Iterations: 10},
+		Instance: typeclass.NewMonoid(0, func(x int, y int) int {
+			return x - y
+		}),
+		Gen:   genInt,
+		Equal: Comparable[int](),
+	})
+	is.Len(rec.failures, 2)
+	is.Contains(rec.failures[0], "semigroup associativity law failed")
+	is.Contains(rec.failures[1], "monoid left identity law failed")
+}
+
+func TestEq(t *testing.T) {
+	is := assert.New(t)
+
+	is.True(Comparable[int]()(42, 42))
+	is.False(Comparable[int]()(42, 21))
+	is.True(DeepEqual[[]int]()([]int{1, 2}, []int{1, 2}))
+	is.False(DeepEqual[[]int]()([]int{1, 2}, []int{2, 1}))
+}
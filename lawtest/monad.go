@@ -0,0 +1,64 @@
+package lawtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/typeclass"
+)
+
+// MonadLaws describes how to check a Monad instance.
+type MonadLaws[A any, FA any, FAA any] struct {
+	Config
+
+	Instance   typeclass.Monad[A, A, FA, FA, FAA]
+	OfFunc     typeclass.Pointed[func(A) A, FAA]
+	GenA       Gen[A]
+	GenFA      Gen[FA]
+	GenFunc    Gen[func(A) A]
+	GenKleisli Gen[func(A) FA]
+	Equal      Eq[FA]
+}
+
+// Monad checks the functor and applicative laws, then the left identity, right identity
+// and associativity laws of a Monad.
+func Monad[A any, FA any, FAA any](t testing.TB, laws MonadLaws[A, FA, FAA]) {
+	t.Helper()
+
+	Applicative(t, ApplicativeLaws[A, FA, FAA]{
+		Config:   laws.Config,
+		Instance: laws.Instance,
+		OfFunc:   laws.OfFunc,
+		GenA:     laws.GenA,
+		GenFA:    laws.GenFA,
+		GenFunc:  laws.GenFunc,
+		Equal:    laws.Equal,
+	})
+
+	m := laws.Instance
+
+	// flatMap(of(a), k) == k(a)
+	check(t, laws.Config, "monad left identity", func(r *rand.Rand) (bool, any, any) {
+		a, k := laws.GenA(r), laws.GenKleisli(r)
+		got := m.FlatMap(m.Of(a), k)
+		want := k(a)
+		return laws.Equal(got, want), got, want
+	})
+
+	// flatMap(fa, of) == fa
+	check(t, laws.Config, "monad right identity", func(r *rand.Rand) (bool, any, any) {
+		fa := laws.GenFA(r)
+		got := m.FlatMap(fa, m.Of)
+		return laws.Equal(got, fa), got, fa
+	})
+
+	// flatMap(flatMap(fa, k), h) == flatMap(fa, a => flatMap(k(a), h))
+	check(t, laws.Config, "monad associativity", func(r *rand.Rand) (bool, any, any) {
+		fa, k, h := laws.GenFA(r), laws.GenKleisli(r), laws.GenKleisli(r)
+		got := m.FlatMap(m.FlatMap(fa, k), h)
+		want := m.FlatMap(fa, func(a A) FA {
+			return m.FlatMap(k(a), h)
+		})
+		return laws.Equal(got, want), got, want
+	})
+}
@@ -0,0 +1,69 @@
+package lawtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/typeclass"
+)
+
+// SemigroupLaws describes how to check a Semigroup instance.
+type SemigroupLaws[T any] struct {
+	Config
+
+	Instance typeclass.Semigroup[T]
+	Gen      Gen[T]
+	Equal    Eq[T]
+}
+
+// Semigroup checks the associativity law of a Semigroup.
+func Semigroup[T any](t testing.TB, laws SemigroupLaws[T]) {
+	t.Helper()
+
+	s := laws.Instance
+
+	// concat(concat(x, y), z) == concat(x, concat(y, z))
+	check(t, laws.Config, "semigroup associativity", func(r *rand.Rand) (bool, any, any) {
+		x, y, z := laws.Gen(r), laws.Gen(r), laws.Gen(r)
+		got := s.Concat(s.Concat(x, y), z)
+		want := s.Concat(x, s.Concat(y, z))
+		return laws.Equal(got, want), got, want
+	})
+}
+
+// MonoidLaws describes how to check a Monoid instance.
+type MonoidLaws[T any] struct {
+	Config
+
+	Instance typeclass.Monoid[T]
+	Gen      Gen[T]
+	Equal    Eq[T]
+}
+
+// Monoid checks the semigroup laws, then the left and right identity laws of a Monoid.
+func Monoid[T any](t testing.TB, laws MonoidLaws[T]) {
+	t.Helper()
+
+	Semigroup(t, SemigroupLaws[T]{
+		Config:   laws.Config,
+		Instance: laws.Instance,
+		Gen:      laws.Gen,
+		Equal:    laws.Equal,
+	})
+
+	m := laws.Instance
+
+	// concat(empty, x) == x
+	check(t, laws.Config, "monoid left identity", func(r *rand.Rand) (bool, any, any) {
+		x := laws.Gen(r)
+		got := m.Concat(m.Empty(), x)
+		return laws.Equal(got, x), got, x
+	})
+
+	// concat(x, empty) == x
+	check(t, laws.Config, "monoid right identity", func(r *rand.Rand) (bool, any, any) {
+		x := laws.Gen(r)
+		got := m.Concat(x, m.Empty())
+		return laws.Equal(got, x), got, x
+	})
+}
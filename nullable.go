@@ -0,0 +1,197 @@
+package mo
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+var nullableNoSuchElement = fmt.Errorf("no such element")
+
+type nullableState int8
+
+const (
+	nullableAbsent nullableState = iota
+	nullableNull
+	nullableValue
+)
+
+// NullableValue builds a Nullable holding value.
+func NullableValue[T any](value T) Nullable[T] {
+	return Nullable[T]{
+		state: nullableValue,
+		value: value,
+	}
+}
+
+// NullableNull builds a Nullable explicitly set to null.
+func NullableNull[T any]() Nullable[T] {
+	return Nullable[T]{
+		state: nullableNull,
+	}
+}
+
+// NullableAbsent builds an absent Nullable. It is the zero value of Nullable.
+func NullableAbsent[T any]() Nullable[T] {
+	return Nullable[T]{
+		state: nullableAbsent,
+	}
+}
+
+// NullableFromOption builds a Nullable holding the value of Some, or set to null for None.
+func NullableFromOption[T any](o Option[T]) Nullable[T] {
+	if o.isPresent {
+		return NullableValue(o.value)
+	}
+	return NullableNull[T]()
+}
+
+// Nullable is a container for a value of type T that can be absent, explicitly null or
+// hold a value, as the fields of JSON merge-patch documents. Unlike Option, it tells a
+// missing field from a field set to null.
+type Nullable[T any] struct {
+	state nullableState
+	value T
+}
+
+// IsAbsent returns true when Nullable is absent.
+func (n Nullable[T]) IsAbsent() bool {
+	return n.state == nullableAbsent
+}
+
+// IsNull returns true when Nullable is explicitly set to null.
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullableNull
+}
+
+// IsPresent returns true when Nullable holds a value.
+func (n Nullable[T]) IsPresent() bool {
+	return n.state == nullableValue
+}
+
+// IsZero returns true when Nullable is absent. It lets encoding/json omit absent fields
+// with the `omitzero` option, and the jsonx package omit them with `omitempty`.
+func (n Nullable[T]) IsZero() bool {
+	return n.state == nullableAbsent
+}
+
+// Get returns value and presence.
+func (n Nullable[T]) Get() (T, bool) {
+	if n.state != nullableValue {
+		return empty[T](), false
+	}
+
+	return n.value, true
+}
+
+// MustGet returns value if present or panics instead.
+func (n Nullable[T]) MustGet() T {
+	if n.state != nullableValue {
+		panic(nullableNoSuchElement)
+	}
+
+	return n.value
+}
+
+// OrElse returns value if present or default value.
+func (n Nullable[T]) OrElse(fallback T) T {
+	if n.state != nullableValue {
+		return fallback
+	}
+
+	return n.value
+}
+
+// OrEmpty returns value if present or empty value.
+func (n Nullable[T]) OrEmpty() T {
+	return n.value
+}
+
+// ToOption returns Some when value is present, or None when absent or null.
+func (n Nullable[T]) ToOption() Option[T] {
+	if n.state != nullableValue {
+		return None[T]()
+	}
+
+	return Some(n.value)
+}
+
+// Apply merges Nullable into current, as a merge-patch does: it returns current when absent,
+// empty value when null, and value when present.
+func (n Nullable[T]) Apply(current T) T {
+	switch n.state {
+	case nullableNull:
+		return empty[T]()
+	case nullableValue:
+		return n.value
+	}
+
+	return current
+}
+
+// MarshalJSON encodes Nullable into json. Absent Nullable is encoded as null, unless
+// omitted with `omitzero`.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state == nullableValue {
+		return json.Marshal(n.value)
+	}
+
+	return json.Marshal(nil)
+}
+
+// UnmarshalJSON decodes Nullable from json. Fields missing from the json document are not
+// decoded and stay absent.
+func (n *Nullable[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.state = nullableNull
+		n.value = empty[T]()
+		return nil
+	}
+
+	err := json.Unmarshal(b, &n.value)
+	if err != nil {
+		return err
+	}
+
+	n.state = nullableValue
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (n Nullable[T]) MarshalText() ([]byte, error) {
+	return json.Marshal(n)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *Nullable[T]) UnmarshalText(data []byte) error {
+	return json.Unmarshal(data, n)
+}
+
+// Scan implements the SQL driver.Scanner interface. NULL is scanned as null.
+func (n *Nullable[T]) Scan(src any) error {
+	if src == nil {
+		n.state = nullableNull
+		n.value = empty[T]()
+		return nil
+	}
+
+	if av, err := driver.DefaultParameterConverter.ConvertValue(src); err == nil {
+		if v, ok := av.(T); ok {
+			n.state = nullableValue
+			n.value = v
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to scan Nullable[T]")
+}
+
+// Value implements the driver Valuer interface. Absent and null Nullable are stored as NULL.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if n.state != nullableValue {
+		return nil, nil
+	}
+
+	return n.value, nil
+}
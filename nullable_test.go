@@ -0,0 +1,172 @@
+package mo
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullable(t *testing.T) {
+	is := assert.New(t)
+
+	value := NullableValue(42)
+	null := NullableNull[int]()
+	absent := NullableAbsent[int]()
+
+	is.Equal(Nullable[int]{}, absent)
+
+	is.True(value.IsPresent())
+	is.False(value.IsNull())
+	is.False(value.IsAbsent())
+	is.False(value.IsZero())
+
+	is.False(null.IsPresent())
+	is.True(null.IsNull())
+	is.False(null.IsAbsent())
+	is.False(null.IsZero())
+
+	is.False(absent.IsPresent())
+	is.False(absent.IsNull())
+	is.True(absent.IsAbsent())
+	is.True(absent.IsZero())
+
+	got, ok := value.Get()
+	is.Equal(42, got)
+	is.True(ok)
+	got, ok = null.Get()
+	is.Equal(0, got)
+	is.False(ok)
+
+	is.Equal(42, value.MustGet())
+	is.PanicsWithError("no such element", func() {
+		null.MustGet()
+	})
+	is.PanicsWithError("no such element", func() {
+		absent.MustGet()
+	})
+
+	is.Equal(42, value.OrElse(1))
+	is.Equal(1, null.OrElse(1))
+	is.Equal(1, absent.OrElse(1))
+	is.Equal(42, value.OrEmpty())
+	is.Equal(0, absent.OrEmpty())
+}
+
+func TestNullableOption(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(Some(42), NullableValue(42).ToOption())
+	is.Equal(None[int](), NullableNull[int]().ToOption())
+	is.Equal(None[int](), NullableAbsent[int]().ToOption())
+
+	is.Equal(NullableValue(42), NullableFromOption(Some(42)))
+	is.Equal(NullableNull[int](), NullableFromOption(None[int]()))
+}
+
+func TestNullableApply(t *testing.T) {
+	is := assert.New(t)
+
+	type testUser struct {
+		Name     string
+		Nickname string
+		Email    string
+	}
+	type testUserPatch struct {
+		Name     Nullable[string] `json:"name"`
+		Nickname Nullable[string] `json:"nickname"`
+		Email    Nullable[string] `json:"email"`
+	}
+
+	user := testUser{Name: "alice", Nickname: "al", Email: "alice@example.com"}
+
+	var patch testUserPatch
+	is.NoError(json.Unmarshal([]byte(`{"name":"alicia","nickname":null}`), &patch))
+	is.Equal(NullableValue("alicia"), patch.Name)
+	is.Equal(NullableNull[string](), patch.Nickname)
+	is.Equal(NullableAbsent[string](), patch.Email)
+
+	user.Name = patch.Name.Apply(user.Name)
+	user.Nickname = patch.Nickname.Apply(user.Nickname)
+	user.Email = patch.Email.Apply(user.Email)
+	is.Equal(testUser{Name: "alicia", Nickname: "", Email: "alice@example.com"}, user)
+}
+
+func TestNullableMarshalJSON(t *testing.T) {
+	is := assert.New(t)
+
+	value, err := json.Marshal(NullableValue("foo"))
+	is.NoError(err)
+	is.Equal(`"foo"`, string(value))
+
+	value, err = json.Marshal(NullableNull[string]())
+	is.NoError(err)
+	is.Equal(`null`, string(value))
+
+	value, err = json.Marshal(NullableAbsent[string]())
+	is.NoError(err)
+	is.Equal(`null`, string(value))
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	is := assert.New(t)
+
+	var nullable Nullable[string]
+
+	is.NoError(json.Unmarshal([]byte(`"foo"`), &nullable))
+	is.Equal(NullableValue("foo"), nullable)
+
+	is.NoError(json.Unmarshal([]byte(`null`), &nullable))
+	is.Equal(NullableNull[string](), nullable)
+
+	is.Error(json.Unmarshal([]byte(`42`), &nullable))
+}
+
+func TestNullableMarshalText(t *testing.T) {
+	is := assert.New(t)
+
+	value, err := NullableValue("foo").MarshalText()
+	is.NoError(err)
+	is.Equal(`"foo"`, string(value))
+
+	var nullable Nullable[string]
+	is.NoError(nullable.UnmarshalText(value))
+	is.Equal(NullableValue("foo"), nullable)
+
+	is.NoError(nullable.UnmarshalText([]byte(`null`)))
+	is.Equal(NullableNull[string](), nullable)
+}
+
+func TestNullableScan(t *testing.T) {
+	is := assert.New(t)
+
+	nullString1, _ := sql.NullString{String: "foo", Valid: true}.Value()
+	nullString2, _ := sql.NullString{String: "", Valid: false}.Value()
+
+	var nullable Nullable[string]
+
+	is.NoError(nullable.Scan(nullString1))
+	is.Equal(NullableValue("foo"), nullable)
+
+	is.NoError(nullable.Scan(nullString2))
+	is.Equal(NullableNull[string](), nullable)
+
+	is.EqualError(nullable.Scan(42), "failed to scan Nullable[T]")
+}
+
+func TestNullableValue(t *testing.T) {
+	is := assert.New(t)
+
+	res, err := NullableValue("foo").Value()
+	is.NoError(err)
+	is.Equal("foo", res)
+
+	res, err = NullableNull[string]().Value()
+	is.NoError(err)
+	is.Nil(res)
+
+	res, err = NullableAbsent[string]().Value()
+	is.NoError(err)
+	is.Nil(res)
+}
@@ -79,6 +79,12 @@ func (o Option[T]) IsAbsent() bool {
 	return !o.isPresent
 }
 
+// IsZero returns true when value is absent. It lets encoding/json omit None with the
+// `omitzero` option, and the jsonx package omit it with `omitempty`.
+func (o Option[T]) IsZero() bool {
+	return !o.isPresent
+}
+
 // Size returns 1 when value is present or 0 instead.
 // Play: https://go.dev/play/p/7ixCNG1E9l7
 func (o Option[T]) Size() int {
@@ -178,7 +184,7 @@ func (o Option[T]) MarshalJSON() ([]byte, error) {
 		return json.Marshal(o.value)
 	}
 
-	// if anybody find a way to support `omitempty` param, please contribute!
+	// None is omitted by `omitzero`, or by `omitempty` with jsonx.Marshal, thanks to IsZero.
 	return json.Marshal(nil)
 }
 
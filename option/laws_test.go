@@ -0,0 +1,49 @@
+package option
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/lawtest"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+func genInt(r *rand.Rand) int {
+	return r.Intn(200) - 100
+}
+
+func genOption(r *rand.Rand) Option[int] {
+	if r.Intn(4) == 0 {
+		return None[int]()
+	}
+	return Some(genInt(r))
+}
+
+func TestOptionLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Option[int], Option[func(int) int]]{
+		Instance: Monad[int, int](),
+		OfFunc:   Monad[func(int) int, func(int) int](),
+		GenA:     genInt,
+		GenFA:    genOption,
+		GenFunc: func(r *rand.Rand) func(int) int {
+			n := genInt(r)
+			return func(i int) int { return i*2 + n }
+		},
+		GenKleisli: func(r *rand.Rand) func(int) Option[int] {
+			n := genInt(r)
+			return func(i int) Option[int] {
+				if (i+n)%3 == 0 {
+					return None[int]()
+				}
+				return Some(i - n)
+			}
+		},
+		Equal: lawtest.Comparable[Option[int]](),
+	})
+
+	lawtest.Monoid(t, lawtest.MonoidLaws[Option[int]]{
+		Instance: Monoid[int](typeclass.SumMonoid[int]()),
+		Gen:      genOption,
+		Equal:    lawtest.Comparable[Option[int]](),
+	})
+}
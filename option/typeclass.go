@@ -0,0 +1,102 @@
+package option
+
+import "github.com/tperdue321/mo/typeclass"
+
+// Monad returns the Functor, Applicative and Monad instance of Option.
+func Monad[A, B any]() typeclass.Monad[A, B, Option[A], Option[B], Option[func(A) B]] {
+	return optionInstance[A, B]{}
+}
+
+// Foldable returns the Foldable instance of Option.
+func Foldable[A, B any]() typeclass.Foldable[A, B, Option[A]] {
+	return optionInstance[A, B]{}
+}
+
+// Filterable returns the Filterable instance of Option.
+func Filterable[A any]() typeclass.Filterable[A, Option[A]] {
+	return optionInstance[A, A]{}
+}
+
+// Traversable returns the Traversable instance of Option, running effects of the
+// applicative `G` described by pointed and functor.
+func Traversable[A, B, GB, GFB any](pointed typeclass.Pointed[Option[B], GFB], functor typeclass.Functor[B, Option[B], GB, GFB]) typeclass.Traversable[A, B, Option[A], GB, GFB] {
+	return optionTraversable[A, B, GB, GFB]{
+		pointed: pointed,
+		functor: functor,
+	}
+}
+
+// Monoid returns the Monoid of Option, combining present values with the given Semigroup.
+// None is the identity element.
+func Monoid[T any](semigroup typeclass.Semigroup[T]) typeclass.Monoid[Option[T]] {
+	return optionMonoid[T]{semigroup: semigroup}
+}
+
+type optionInstance[A, B any] struct{}
+
+func (optionInstance[A, B]) Of(a A) Option[A] {
+	return Some(a)
+}
+
+func (optionInstance[A, B]) Map(fa Option[A], f func(A) B) Option[B] {
+	return WrapOption[A, B](fa).Map(f)
+}
+
+func (optionInstance[A, B]) Ap(fab Option[func(A) B], fa Option[A]) Option[B] {
+	return WrapOption[A, B](fa).Apply(fab)
+}
+
+func (optionInstance[A, B]) FlatMap(fa Option[A], f func(A) Option[B]) Option[B] {
+	return WrapOption[A, B](fa).FlatMap(f)
+}
+
+func (optionInstance[A, B]) FoldLeft(fa Option[A], initial B, f func(B, A) B) B {
+	if value, ok := fa.Get(); ok {
+		return f(initial, value)
+	}
+	return initial
+}
+
+func (optionInstance[A, B]) FoldRight(fa Option[A], initial B, f func(A, B) B) B {
+	if value, ok := fa.Get(); ok {
+		return f(value, initial)
+	}
+	return initial
+}
+
+func (optionInstance[A, B]) Filter(fa Option[A], predicate func(A) bool) Option[A] {
+	if value, ok := fa.Get(); ok && predicate(value) {
+		return fa
+	}
+	return None[A]()
+}
+
+type optionTraversable[A, B, GB, GFB any] struct {
+	pointed typeclass.Pointed[Option[B], GFB]
+	functor typeclass.Functor[B, Option[B], GB, GFB]
+}
+
+func (t optionTraversable[A, B, GB, GFB]) Traverse(fa Option[A], f func(A) GB) GFB {
+	if value, ok := fa.Get(); ok {
+		return t.functor.Map(f(value), Some[B])
+	}
+	return t.pointed.Of(None[B]())
+}
+
+type optionMonoid[T any] struct {
+	semigroup typeclass.Semigroup[T]
+}
+
+func (m optionMonoid[T]) Empty() Option[T] {
+	return None[T]()
+}
+
+func (m optionMonoid[T]) Concat(x Option[T], y Option[T]) Option[T] {
+	if x.IsAbsent() {
+		return y
+	}
+	if y.IsAbsent() {
+		return x
+	}
+	return Some(m.semigroup.Concat(x.MustGet(), y.MustGet()))
+}
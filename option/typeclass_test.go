@@ -0,0 +1,96 @@
+package option
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+func double[FA, FB any](functor typeclass.Functor[int, int, FA, FB], fa FA) FB {
+	return functor.Map(fa, func(i int) int {
+		return i * 2
+	})
+}
+
+func TestOptionMonad(t *testing.T) {
+	is := assert.New(t)
+
+	m := Monad[int, string]()
+
+	is.Equal(Some(42), m.Of(42))
+	is.Equal(Some("42"), m.Map(Some(42), strconv.Itoa))
+	is.Equal(None[string](), m.Map(None[int](), strconv.Itoa))
+	is.Equal(Some("42"), m.Ap(Some(strconv.Itoa), Some(42)))
+	is.Equal(None[string](), m.Ap(None[func(int) string](), Some(42)))
+	is.Equal(None[string](), m.Ap(Some(strconv.Itoa), None[int]()))
+	is.Equal(Some("42"), m.FlatMap(Some(42), func(i int) Option[string] {
+		return Some(strconv.Itoa(i))
+	}))
+	is.Equal(None[string](), m.FlatMap(Some(42), func(i int) Option[string] {
+		return None[string]()
+	}))
+
+	is.Equal(Some(84), double[Option[int], Option[int]](Monad[int, int](), Some(42)))
+}
+
+func TestOptionFoldable(t *testing.T) {
+	is := assert.New(t)
+
+	f := Foldable[int, string]()
+
+	is.Equal("foo42", f.FoldLeft(Some(42), "foo", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	}))
+	is.Equal("42foo", f.FoldRight(Some(42), "foo", func(i int, acc string) string {
+		return strconv.Itoa(i) + acc
+	}))
+	is.Equal("foo", f.FoldLeft(None[int](), "foo", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	}))
+}
+
+func TestOptionFilterable(t *testing.T) {
+	is := assert.New(t)
+
+	f := Filterable[int]()
+	isEven := func(i int) bool {
+		return i%2 == 0
+	}
+
+	is.Equal(Some(42), f.Filter(Some(42), isEven))
+	is.Equal(None[int](), f.Filter(Some(21), isEven))
+	is.Equal(None[int](), f.Filter(None[int](), isEven))
+}
+
+func TestOptionTraversable(t *testing.T) {
+	is := assert.New(t)
+
+	tr := Traversable[string, int, Option[int], Option[Option[int]]](
+		Monad[Option[int], Option[int]](),
+		Monad[int, Option[int]](),
+	)
+	parse := func(s string) Option[int] {
+		if v, err := strconv.Atoi(s); err == nil {
+			return Some(v)
+		}
+		return None[int]()
+	}
+
+	is.Equal(Some(Some(42)), tr.Traverse(Some("42"), parse))
+	is.Equal(None[Option[int]](), tr.Traverse(Some("foo"), parse))
+	is.Equal(Some(None[int]()), tr.Traverse(None[string](), parse))
+}
+
+func TestOptionMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	m := Monoid[int](typeclass.SumMonoid[int]())
+
+	is.Equal(None[int](), m.Empty())
+	is.Equal(Some(63), m.Concat(Some(21), Some(42)))
+	is.Equal(Some(21), m.Concat(Some(21), None[int]()))
+	is.Equal(Some(42), m.Concat(None[int](), Some(42)))
+	is.Equal(None[int](), m.Concat(None[int](), None[int]()))
+}
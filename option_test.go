@@ -69,6 +69,14 @@ func TestOptionIsAbsent(t *testing.T) {
 	is.True(None[int]().IsAbsent())
 }
 
+func TestOptionIsZero(t *testing.T) {
+	is := assert.New(t)
+
+	is.False(Some(42).IsZero())
+	is.False(Some(0).IsZero())
+	is.True(None[int]().IsZero())
+}
+
 func TestOptionSize(t *testing.T) {
 	is := assert.New(t)
 
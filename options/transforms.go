@@ -10,44 +10,107 @@
 // - The `io.Reader` defines a single method, and all manipulations of a reader is done on packages `io` and `ioutil`.
 package options
 
-// import (
-// 	"github.com/samber/mo"
-// )
-
-// // Map returns a new `mo.Option` wrapping the result of applying `f` to the value of opt, if present, and None otherwise.
-// func Map[I any, O any](opt mo.Option[I], f func(I) O) mo.Option[O] {
-// 	if val, ok := opt.Get(); ok {
-// 		return mo.Some(f(val))
-// 	}
-
-// 	return mo.None[O]()
-// }
-
-// // FlatMap returns the result of applying `f` to the value of opt, if present, and None otherwise.
-// func FlatMap[I any, O any](opt mo.Option[I], f func(I) mo.Option[O]) mo.Option[O] {
-// 	if val, ok := opt.Get(); ok {
-// 		return f(val)
-// 	}
-
-// 	return mo.None[O]()
-// }
-
-// // Match returns a new `mo.Option` from the result of applying `onValue` to the value of opt, if present,
-// // or from the result of calling `onNone` if absent.
-// func Match[I any, O any](opt mo.Option[I], onValue func(I) (O, bool), onNone func() (O, bool)) mo.Option[O] {
-// 	if val, ok := opt.Get(); ok {
-// 		return mo.TupleToOption(onValue(val))
-// 	}
-
-// 	return mo.TupleToOption(onNone())
-// }
-
-// // FlatMatch returns the result of applying `onValue` to the value of opt, if present,
-// // or the result of `onNone` if absent.
-// func FlatMatch[I any, O any](opt mo.Option[I], onValue func(I) O, onNone func() O) O {
-// 	if val, ok := opt.Get(); ok {
-// 		return onValue(val)
-// 	}
-
-// 	return onNone()
-// }
+import (
+	"github.com/tperdue321/mo"
+)
+
+// Map returns a new `mo.Option` wrapping the result of applying `f` to the value of opt, if present, and None otherwise.
+func Map[I any, O any](opt mo.Option[I], f func(I) O) mo.Option[O] {
+	if val, ok := opt.Get(); ok {
+		return mo.Some(f(val))
+	}
+
+	return mo.None[O]()
+}
+
+// FlatMap returns the result of applying `f` to the value of opt, if present, and None otherwise.
+func FlatMap[I any, O any](opt mo.Option[I], f func(I) mo.Option[O]) mo.Option[O] {
+	if val, ok := opt.Get(); ok {
+		return f(val)
+	}
+
+	return mo.None[O]()
+}
+
+// Match returns a new `mo.Option` from the result of applying `onValue` to the value of opt, if present,
+// or from the result of calling `onNone` if absent.
+func Match[I any, O any](opt mo.Option[I], onValue func(I) (O, bool), onNone func() (O, bool)) mo.Option[O] {
+	if val, ok := opt.Get(); ok {
+		return mo.TupleToOption(onValue(val))
+	}
+
+	return mo.TupleToOption(onNone())
+}
+
+// FlatMatch returns the result of applying `onValue` to the value of opt, if present,
+// or the result of `onNone` if absent.
+func FlatMatch[I any, O any](opt mo.Option[I], onValue func(I) O, onNone func() O) O {
+	if val, ok := opt.Get(); ok {
+		return onValue(val)
+	}
+
+	return onNone()
+}
+
+// Zip returns a `mo.Option` holding both values when both options are present, and None otherwise.
+func Zip[A any, B any](a mo.Option[A], b mo.Option[B]) mo.Option[mo.Tuple2[A, B]] {
+	valA, okA := a.Get()
+	valB, okB := b.Get()
+	if okA && okB {
+		return mo.Some(mo.T2(valA, valB))
+	}
+
+	return mo.None[mo.Tuple2[A, B]]()
+}
+
+// Unzip splits an option of tuple into a pair of options. Both are None when opt is absent.
+func Unzip[A any, B any](opt mo.Option[mo.Tuple2[A, B]]) (mo.Option[A], mo.Option[B]) {
+	if val, ok := opt.Get(); ok {
+		return mo.Some(val.A), mo.Some(val.B)
+	}
+
+	return mo.None[A](), mo.None[B]()
+}
+
+// Filter returns opt when its value is present and satisfies the predicate, and None otherwise.
+func Filter[T any](opt mo.Option[T], predicate func(T) bool) mo.Option[T] {
+	if val, ok := opt.Get(); ok && predicate(val) {
+		return opt
+	}
+
+	return mo.None[T]()
+}
+
+// Fold applies `f` to the initial value and the value of opt, if present, or returns the initial value otherwise.
+func Fold[I any, O any](opt mo.Option[I], initial O, f func(O, I) O) O {
+	if val, ok := opt.Get(); ok {
+		return f(initial, val)
+	}
+
+	return initial
+}
+
+// Sequence turns a slice of options into an option of slice. It returns None as soon as
+// one of the options is absent.
+func Sequence[T any](opts []mo.Option[T]) mo.Option[[]T] {
+	return Traverse(opts, func(opt mo.Option[T]) mo.Option[T] {
+		return opt
+	})
+}
+
+// Traverse applies `f` to every item and collects the results into an option of slice.
+// It returns None as soon as `f` returns None.
+func Traverse[I any, O any](items []I, f func(I) mo.Option[O]) mo.Option[[]O] {
+	result := make([]O, 0, len(items))
+
+	for _, item := range items {
+		val, ok := f(item).Get()
+		if !ok {
+			return mo.None[[]O]()
+		}
+
+		result = append(result, val)
+	}
+
+	return mo.Some(result)
+}
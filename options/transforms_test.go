@@ -0,0 +1,127 @@
+package options
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo"
+)
+
+func TestMap(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(mo.Some("42"), Map(mo.Some(42), strconv.Itoa))
+	is.Equal(mo.None[string](), Map(mo.None[int](), strconv.Itoa))
+}
+
+func TestFlatMap(t *testing.T) {
+	is := assert.New(t)
+
+	parse := func(s string) mo.Option[int] {
+		v, err := strconv.Atoi(s)
+		return mo.TupleToOption(v, err == nil)
+	}
+
+	is.Equal(mo.Some(42), FlatMap(mo.Some("42"), parse))
+	is.Equal(mo.None[int](), FlatMap(mo.Some("foo"), parse))
+	is.Equal(mo.None[int](), FlatMap(mo.None[string](), parse))
+}
+
+func TestMatch(t *testing.T) {
+	is := assert.New(t)
+
+	onValue := func(i int) (string, bool) {
+		return strconv.Itoa(i), i > 0
+	}
+	onNone := func() (string, bool) {
+		return "none", true
+	}
+
+	is.Equal(mo.Some("42"), Match(mo.Some(42), onValue, onNone))
+	is.Equal(mo.None[string](), Match(mo.Some(-1), onValue, onNone))
+	is.Equal(mo.Some("none"), Match(mo.None[int](), onValue, onNone))
+}
+
+func TestFlatMatch(t *testing.T) {
+	is := assert.New(t)
+
+	onValue := func(i int) string {
+		return strconv.Itoa(i)
+	}
+	onNone := func() string {
+		return "none"
+	}
+
+	is.Equal("42", FlatMatch(mo.Some(42), onValue, onNone))
+	is.Equal("none", FlatMatch(mo.None[int](), onValue, onNone))
+}
+
+func TestZip(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(mo.Some(mo.T2(42, "foo")), Zip(mo.Some(42), mo.Some("foo")))
+	is.Equal(mo.None[mo.Tuple2[int, string]](), Zip(mo.None[int](), mo.Some("foo")))
+	is.Equal(mo.None[mo.Tuple2[int, string]](), Zip(mo.Some(42), mo.None[string]()))
+}
+
+func TestUnzip(t *testing.T) {
+	is := assert.New(t)
+
+	a, b := Unzip(mo.Some(mo.T2(42, "foo")))
+	is.Equal(mo.Some(42), a)
+	is.Equal(mo.Some("foo"), b)
+
+	a, b = Unzip(mo.None[mo.Tuple2[int, string]]())
+	is.Equal(mo.None[int](), a)
+	is.Equal(mo.None[string](), b)
+}
+
+func TestFilter(t *testing.T) {
+	is := assert.New(t)
+
+	isEven := func(i int) bool {
+		return i%2 == 0
+	}
+
+	is.Equal(mo.Some(42), Filter(mo.Some(42), isEven))
+	is.Equal(mo.None[int](), Filter(mo.Some(21), isEven))
+	is.Equal(mo.None[int](), Filter(mo.None[int](), isEven))
+}
+
+func TestFold(t *testing.T) {
+	is := assert.New(t)
+
+	concat := func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	}
+
+	is.Equal("foo42", Fold(mo.Some(42), "foo", concat))
+	is.Equal("foo", Fold(mo.None[int](), "foo", concat))
+}
+
+func TestSequence(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(mo.Some([]int{1, 2, 3}), Sequence([]mo.Option[int]{mo.Some(1), mo.Some(2), mo.Some(3)}))
+	is.Equal(mo.None[[]int](), Sequence([]mo.Option[int]{mo.Some(1), mo.None[int](), mo.Some(3)}))
+	is.Equal(mo.Some([]int{}), Sequence([]mo.Option[int]{}))
+}
+
+func TestTraverse(t *testing.T) {
+	is := assert.New(t)
+
+	calls := 0
+	parse := func(s string) mo.Option[int] {
+		calls++
+		v, err := strconv.Atoi(s)
+		return mo.TupleToOption(v, err == nil)
+	}
+
+	is.Equal(mo.Some([]int{1, 2, 3}), Traverse([]string{"1", "2", "3"}, parse))
+	is.Equal(3, calls)
+
+	calls = 0
+	is.Equal(mo.None[[]int](), Traverse([]string{"1", "foo", "3"}, parse))
+	is.Equal(2, calls)
+}
@@ -0,0 +1,73 @@
+package mo
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error a recovered panic is turned into.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value when it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// RunOption configures how Future, Task and IOEither constructors run their callbacks.
+// IO constructors take none, since IO cannot fail.
+type RunOption func(*runOptions)
+
+type runOptions struct {
+	recoverPanics bool
+	// executor is nil when callbacks run on their own goroutine.
+	executor Executor
+}
+
+func newRunOptions(opts []RunOption) runOptions {
+	options := runOptions{
+		recoverPanics: true,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// inline returns the options without executor, for Futures created by a callback
+// already running on the executor.
+func (o runOptions) inline() runOptions {
+	o.executor = nil
+	return o
+}
+
+// WithPanicRecovery sets whether panics of the callback are recovered into a *PanicError.
+// Recovery is enabled by default: disable it to let panics crash the process, eg: in tests.
+func WithPanicRecovery(enabled bool) RunOption {
+	return func(options *runOptions) {
+		options.recoverPanics = enabled
+	}
+}
+
+// recoverPanic passes a *PanicError to onPanic when the goroutine is panicking.
+// It must be deferred.
+func recoverPanic(onPanic func(error)) {
+	if r := recover(); r != nil {
+		onPanic(&PanicError{
+			Value: r,
+			Stack: debug.Stack(),
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package mo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanicError(t *testing.T) {
+	is := assert.New(t)
+
+	err := &PanicError{Value: "boom"}
+	is.Equal("panic: boom", err.Error())
+	is.Nil(err.Unwrap())
+
+	err = &PanicError{Value: assert.AnError}
+	is.True(errors.Is(err, assert.AnError))
+}
+
+func TestFuturePanicRecovery(t *testing.T) {
+	is := assert.New(t)
+
+	_, err := NewFuture(func(resolve func(int), reject func(error)) {
+		panic("boom")
+	}).Collect()
+
+	var panicErr *PanicError
+	is.True(errors.As(err, &panicErr))
+	is.Equal("boom", panicErr.Value)
+	is.Contains(string(panicErr.Stack), "TestFuturePanicRecovery")
+
+	value, err := NewFuture(func(resolve func(int), reject func(error)) {
+		resolve(42)
+	}).Then(func(value int) (int, error) {
+		panic(assert.AnError)
+	}).Catch(func(err error) (int, error) {
+		is.ErrorIs(err, assert.AnError)
+		return 21, nil
+	}).Collect()
+
+	is.NoError(err)
+	is.Equal(21, value)
+}
+
+func TestFuturePanicRecoveryDisabled(t *testing.T) {
+	is := assert.New(t)
+
+	is.True(newRunOptions(nil).recoverPanics)
+	is.False(newRunOptions([]RunOption{WithPanicRecovery(false)}).recoverPanics)
+
+	// run the callback synchronously, since a panicking goroutine would crash the test binary
+	future := &Future[int]{
+		cb: func(resolve func(int), reject func(error)) {
+			panic("boom")
+		},
+		done:    make(chan struct{}),
+		options: newRunOptions([]RunOption{WithPanicRecovery(false)}),
+	}
+	is.PanicsWithValue("boom", future.activeSync)
+}
+
+func TestFutureSubscriberPanicRecovery(t *testing.T) {
+	is := assert.New(t)
+
+	release := make(chan struct{})
+	fut := NewFuture(func(resolve func(int), reject func(error)) {
+		<-release
+		resolve(42)
+	})
+
+	fut.OnComplete(func(value int, err error) {
+		panic("boom")
+	})
+	subscribed := make(chan int)
+	fut.OnComplete(func(value int, err error) {
+		subscribed <- value
+	})
+
+	close(release)
+	value, err := fut.Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+	is.Equal(42, <-subscribed)
+
+	// callbacks subscribed once settled behave the same
+	is.NotPanics(func() {
+		fut.OnComplete(func(value int, err error) {
+			panic("boom")
+		})
+	})
+
+	// run the settlement synchronously, since a panicking goroutine would crash the test binary
+	future := &Future[int]{
+		done:    make(chan struct{}),
+		options: newRunOptions([]RunOption{WithPanicRecovery(false)}),
+	}
+	future.OnComplete(func(value int, err error) {
+		panic("boom")
+	})
+	var ran bool
+	future.OnComplete(func(value int, err error) {
+		ran = true
+	})
+	is.PanicsWithValue("boom", func() {
+		future.resolve(42)
+	})
+	is.True(ran)
+	value, err = future.Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+
+	is.PanicsWithValue("boom", func() {
+		future.OnComplete(func(value int, err error) {
+			panic("boom")
+		})
+	})
+}
+
+func TestTaskPanicRecovery(t *testing.T) {
+	is := assert.New(t)
+
+	_, err := NewTask(func() *Future[int] {
+		panic("boom")
+	}).Run().Collect()
+
+	var panicErr *PanicError
+	is.True(errors.As(err, &panicErr))
+	is.Equal("boom", panicErr.Value)
+
+	_, err = NewTaskFromIO2(NewIO2(func(a int, b int) int {
+		panic("boom")
+	})).Run(1, 2).Collect()
+
+	is.True(errors.As(err, &panicErr))
+
+	is.PanicsWithValue("boom", func() {
+		NewTask1(func(a int) *Future[int] {
+			panic("boom")
+		}, WithPanicRecovery(false)).Run(1)
+	})
+}
+
+func TestIOEitherPanicRecovery(t *testing.T) {
+	is := assert.New(t)
+
+	either := NewIOEither(func() (int, error) {
+		panic("boom")
+	}).Run()
+
+	var panicErr *PanicError
+	is.True(either.IsLeft())
+	is.True(errors.As(either.MustLeft(), &panicErr))
+	is.Equal("boom", panicErr.Value)
+
+	either = NewIOEither3(func(a int, b int, c int) (int, error) {
+		panic("boom")
+	}).Run(1, 2, 3)
+
+	is.True(either.IsLeft())
+
+	is.PanicsWithValue("boom", func() {
+		NewIOEither(func() (int, error) {
+			panic("boom")
+		}, WithPanicRecovery(false)).Run()
+	})
+}
+
+func TestIOPanic(t *testing.T) {
+	is := assert.New(t)
+
+	io := NewIO(func() int {
+		panic("boom")
+	})
+
+	is.PanicsWithValue("boom", func() {
+		io.Run()
+	})
+
+	either := NewIOEitherFromIO(io).Run()
+
+	var panicErr *PanicError
+	is.True(either.IsLeft())
+	is.True(errors.As(either.MustLeft(), &panicErr))
+	is.Equal("boom", panicErr.Value)
+
+	is.Equal(Right[error, int](42), NewIOEitherFromIO(NewIO(func() int {
+		return 42
+	})).Run())
+
+	is.PanicsWithValue("boom", func() {
+		NewIOEitherFromIO(io, WithPanicRecovery(false)).Run()
+	})
+}
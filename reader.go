@@ -0,0 +1,86 @@
+package mo
+
+// NewReader instanciates a new Reader.
+func NewReader[E any, A any](f func(env E) A) Reader[E, A] {
+	return Reader[E, A]{
+		run: f,
+	}
+}
+
+// ReturnReader instanciates a new Reader yielding x whatever the environment.
+func ReturnReader[E any, A any](x A) Reader[E, A] {
+	return Reader[E, A]{
+		run: func(env E) A {
+			return x
+		},
+	}
+}
+
+// Ask returns a Reader yielding the environment.
+func Ask[E any]() Reader[E, E] {
+	return Reader[E, E]{
+		run: func(env E) E {
+			return env
+		},
+	}
+}
+
+// Asks returns a Reader applying f to the environment.
+func Asks[E any, A any](f func(env E) A) Reader[E, A] {
+	return NewReader(f)
+}
+
+// Reader represents a function `(E) -> A`, where `E` is an environment, `A` is result.
+// It lets computations read shared dependencies without passing them explicitly.
+type Reader[E any, A any] struct {
+	run func(env E) A
+}
+
+// Run executes the computation with env.
+func (r Reader[E, A]) Run(env E) A {
+	return r.run(env)
+}
+
+// Local runs the computation with the environment modified by f.
+func (r Reader[E, A]) Local(f func(env E) E) Reader[E, A] {
+	return LocalReader(r, f)
+}
+
+// Map applies a function to the result of the computation.
+func (r Reader[E, A]) Map(f func(A) A) Reader[E, A] {
+	return MapReader(r, f)
+}
+
+// FlatMap runs the computation returned by f for the result of the computation, with
+// the same environment.
+func (r Reader[E, A]) FlatMap(f func(A) Reader[E, A]) Reader[E, A] {
+	return FlatMapReader(r, f)
+}
+
+// LocalReader runs r with the environment built by f from the outer environment.
+func LocalReader[E any, L any, A any](r Reader[L, A], f func(env E) L) Reader[E, A] {
+	return Reader[E, A]{
+		run: func(env E) A {
+			return r.run(f(env))
+		},
+	}
+}
+
+// MapReader applies a function to the result of the computation.
+func MapReader[E any, A any, B any](r Reader[E, A], f func(A) B) Reader[E, B] {
+	return Reader[E, B]{
+		run: func(env E) B {
+			return f(r.run(env))
+		},
+	}
+}
+
+// FlatMapReader runs the computation returned by f for the result of the computation,
+// with the same environment.
+func FlatMapReader[E any, A any, B any](r Reader[E, A], f func(A) Reader[E, B]) Reader[E, B] {
+	return Reader[E, B]{
+		run: func(env E) B {
+			return f(r.run(env)).run(env)
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package mo
+
+// NewReaderIOEither instanciates a new ReaderIOEither.
+func NewReaderIOEither[E any, A any](f func(env E) IOEither[A]) ReaderIOEither[E, A] {
+	return ReaderIOEither[E, A]{
+		run: f,
+	}
+}
+
+// NewReaderIOEitherFromReader instanciates a new ReaderIOEither from a Reader that never fails.
+func NewReaderIOEitherFromReader[E any, A any](r Reader[E, A]) ReaderIOEither[E, A] {
+	return ReaderIOEither[E, A]{
+		run: func(env E) IOEither[A] {
+			return NewIOEither(func() (A, error) {
+				return r.run(env), nil
+			})
+		},
+	}
+}
+
+// NewReaderIOEitherFromIOEither instanciates a new ReaderIOEither running io whatever the environment.
+func NewReaderIOEitherFromIOEither[E any, A any](io IOEither[A]) ReaderIOEither[E, A] {
+	return ReaderIOEither[E, A]{
+		run: func(env E) IOEither[A] {
+			return io
+		},
+	}
+}
+
+// AskReaderIOEither returns a ReaderIOEither yielding the environment.
+func AskReaderIOEither[E any]() ReaderIOEither[E, E] {
+	return NewReaderIOEitherFromReader(Ask[E]())
+}
+
+// AsksReaderIOEither returns a ReaderIOEither applying f to the environment.
+func AsksReaderIOEither[E any, A any](f func(env E) A) ReaderIOEither[E, A] {
+	return NewReaderIOEitherFromReader(Asks(f))
+}
+
+// ReaderIOEither represents a function `(E) -> IOEither[A]`, where `E` is an environment,
+// `A` is result. Dependencies are supplied once with Provide.
+type ReaderIOEither[E any, A any] struct {
+	run func(env E) IOEither[A]
+}
+
+// Provide returns the IOEither of the computation for env, with its own options. Nothing
+// runs until Run is called on it.
+func (r ReaderIOEither[E, A]) Provide(env E) IOEither[A] {
+	return r.run(env)
+}
+
+// Local runs the computation with the environment modified by f.
+func (r ReaderIOEither[E, A]) Local(f func(env E) E) ReaderIOEither[E, A] {
+	return LocalReaderIOEither(r, f)
+}
+
+// Map applies a function to the value of the computation.
+func (r ReaderIOEither[E, A]) Map(f func(A) A) ReaderIOEither[E, A] {
+	return MapReaderIOEither(r, f)
+}
+
+// FlatMap runs the computation returned by f for the value of the computation, with
+// the same environment.
+func (r ReaderIOEither[E, A]) FlatMap(f func(A) ReaderIOEither[E, A]) ReaderIOEither[E, A] {
+	return FlatMapReaderIOEither(r, f)
+}
+
+// LocalReaderIOEither runs r with the environment built by f from the outer environment.
+func LocalReaderIOEither[E any, L any, A any](r ReaderIOEither[L, A], f func(env E) L) ReaderIOEither[E, A] {
+	return ReaderIOEither[E, A]{
+		run: func(env E) IOEither[A] {
+			return r.Provide(f(env))
+		},
+	}
+}
+
+// MapReaderIOEither applies a function to the value of the computation.
+func MapReaderIOEither[E any, A any, B any](r ReaderIOEither[E, A], f func(A) B) ReaderIOEither[E, B] {
+	return ReaderIOEither[E, B]{
+		run: func(env E) IOEither[B] {
+			return MapIOEither(r.Provide(env), f)
+		},
+	}
+}
+
+// FlatMapReaderIOEither runs the computation returned by f for the value of the
+// computation, with the same environment. f is not called when the computation fails.
+func FlatMapReaderIOEither[E any, A any, B any](r ReaderIOEither[E, A], f func(A) ReaderIOEither[E, B]) ReaderIOEither[E, B] {
+	return ReaderIOEither[E, B]{
+		run: func(env E) IOEither[B] {
+			return FlatMapIOEither(r.Provide(env), func(value A) IOEither[B] {
+				return f(value).Provide(env)
+			})
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package mo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaderIOEither(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+
+	calls := 0
+	reader := NewReaderIOEither(func(env testEnv) IOEither[string] {
+		return NewIOEither(func() (string, error) {
+			calls++
+			return env.prefix + "42", nil
+		})
+	})
+	io := reader.Provide(env)
+	is.Equal(0, calls)
+	is.Equal(Right[error, string]("id-42"), io.Run())
+	is.Equal(1, calls)
+
+	// the IOEither keeps its own options
+	is.PanicsWithValue("boom", func() {
+		NewReaderIOEither(func(env testEnv) IOEither[int] {
+			return NewIOEither(func() (int, error) {
+				panic("boom")
+			}, WithPanicRecovery(false))
+		}).Provide(env).Run()
+	})
+
+	is.Equal(Right[error, string]("id-"), NewReaderIOEitherFromReader(Asks(func(env testEnv) string {
+		return env.prefix
+	})).Provide(env).Run())
+	is.Equal(Left[error, int](assert.AnError), NewReaderIOEitherFromIOEither[testEnv](NewIOEitherFromResult(Err[int](assert.AnError))).Provide(env).Run())
+	is.Equal(Right[error, testEnv](env), AskReaderIOEither[testEnv]().Provide(env).Run())
+	is.Equal(Right[error, int](2), AsksReaderIOEither(func(env testEnv) int {
+		return env.factor
+	}).Provide(env).Run())
+}
+
+func TestReaderIOEitherMethods(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+	prefix := AsksReaderIOEither(func(env testEnv) string {
+		return env.prefix
+	})
+
+	is.Equal(Right[error, string]("user-"), prefix.Local(func(env testEnv) testEnv {
+		env.prefix = "user-"
+		return env
+	}).Provide(env).Run())
+	is.Equal(Right[error, string]("id-!"), prefix.Map(func(result string) string {
+		return result + "!"
+	}).Provide(env).Run())
+	is.Equal(Right[error, string]("id-2"), prefix.FlatMap(func(result string) ReaderIOEither[testEnv, string] {
+		return AsksReaderIOEither(func(env testEnv) string {
+			return result + strconv.Itoa(env.factor)
+		})
+	}).Provide(env).Run())
+}
+
+func TestReaderIOEitherComposition(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+	factor := AsksReaderIOEither(func(env testEnv) int {
+		return env.factor
+	})
+	failing := NewReaderIOEitherFromIOEither[testEnv](NewIOEitherFromResult(Err[int](assert.AnError)))
+
+	is.Equal(Right[error, string]("2"), MapReaderIOEither(factor, strconv.Itoa).Provide(env).Run())
+	is.Equal(Left[error, string](assert.AnError), MapReaderIOEither(failing, strconv.Itoa).Provide(env).Run())
+
+	is.Equal(Right[error, int](42), FlatMapReaderIOEither(factor, func(result int) ReaderIOEither[testEnv, int] {
+		return AsksReaderIOEither(func(env testEnv) int {
+			return result * 21
+		})
+	}).Provide(env).Run())
+	is.Equal(Left[error, int](assert.AnError), FlatMapReaderIOEither(failing, func(result int) ReaderIOEither[testEnv, int] {
+		is.Fail("should not enter here")
+		return factor
+	}).Provide(env).Run())
+
+	is.Equal(Right[error, int](2), LocalReaderIOEither(AskReaderIOEither[int](), func(env testEnv) int {
+		return env.factor
+	}).Provide(env).Run())
+}
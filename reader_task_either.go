@@ -0,0 +1,105 @@
+package mo
+
+// NewReaderTaskEither instanciates a new ReaderTaskEither.
+func NewReaderTaskEither[E any, A any](f func(env E) TaskEither[A]) ReaderTaskEither[E, A] {
+	return ReaderTaskEither[E, A]{
+		run: f,
+	}
+}
+
+// NewReaderTaskEitherFromReader instanciates a new ReaderTaskEither from a Reader that never fails.
+func NewReaderTaskEitherFromReader[E any, A any](r Reader[E, A]) ReaderTaskEither[E, A] {
+	return ReaderTaskEither[E, A]{
+		run: func(env E) TaskEither[A] {
+			return NewTaskEitherFromFunc(func() (A, error) {
+				return r.run(env), nil
+			})
+		},
+	}
+}
+
+// NewReaderTaskEitherFromReaderIOEither instanciates a new ReaderTaskEither from a ReaderIOEither.
+func NewReaderTaskEitherFromReaderIOEither[E any, A any](r ReaderIOEither[E, A]) ReaderTaskEither[E, A] {
+	return ReaderTaskEither[E, A]{
+		run: func(env E) TaskEither[A] {
+			return NewTaskEitherFromIOEither(r.Provide(env))
+		},
+	}
+}
+
+// NewReaderTaskEitherFromTaskEither instanciates a new ReaderTaskEither running task whatever the environment.
+func NewReaderTaskEitherFromTaskEither[E any, A any](task TaskEither[A]) ReaderTaskEither[E, A] {
+	return ReaderTaskEither[E, A]{
+		run: func(env E) TaskEither[A] {
+			return task
+		},
+	}
+}
+
+// AskReaderTaskEither returns a ReaderTaskEither yielding the environment.
+func AskReaderTaskEither[E any]() ReaderTaskEither[E, E] {
+	return NewReaderTaskEitherFromReader(Ask[E]())
+}
+
+// AsksReaderTaskEither returns a ReaderTaskEither applying f to the environment.
+func AsksReaderTaskEither[E any, A any](f func(env E) A) ReaderTaskEither[E, A] {
+	return NewReaderTaskEitherFromReader(Asks(f))
+}
+
+// ReaderTaskEither represents a function `(E) -> TaskEither[A]`, where `E` is an environment,
+// `A` is result. Dependencies are supplied once with Provide.
+type ReaderTaskEither[E any, A any] struct {
+	run func(env E) TaskEither[A]
+}
+
+// Provide returns the TaskEither of the computation for env, with its own options. Nothing
+// runs until Run is called on it.
+func (r ReaderTaskEither[E, A]) Provide(env E) TaskEither[A] {
+	return r.run(env)
+}
+
+// Local runs the computation with the environment modified by f.
+func (r ReaderTaskEither[E, A]) Local(f func(env E) E) ReaderTaskEither[E, A] {
+	return LocalReaderTaskEither(r, f)
+}
+
+// Map applies a function to the value of the computation.
+func (r ReaderTaskEither[E, A]) Map(f func(A) A) ReaderTaskEither[E, A] {
+	return MapReaderTaskEither(r, f)
+}
+
+// FlatMap runs the computation returned by f for the value of the computation, with
+// the same environment.
+func (r ReaderTaskEither[E, A]) FlatMap(f func(A) ReaderTaskEither[E, A]) ReaderTaskEither[E, A] {
+	return FlatMapReaderTaskEither(r, f)
+}
+
+// LocalReaderTaskEither runs r with the environment built by f from the outer environment.
+func LocalReaderTaskEither[E any, L any, A any](r ReaderTaskEither[L, A], f func(env E) L) ReaderTaskEither[E, A] {
+	return ReaderTaskEither[E, A]{
+		run: func(env E) TaskEither[A] {
+			return r.Provide(f(env))
+		},
+	}
+}
+
+// MapReaderTaskEither applies a function to the value of the computation.
+func MapReaderTaskEither[E any, A any, B any](r ReaderTaskEither[E, A], f func(A) B) ReaderTaskEither[E, B] {
+	return ReaderTaskEither[E, B]{
+		run: func(env E) TaskEither[B] {
+			return MapTaskEither(r.Provide(env), f)
+		},
+	}
+}
+
+// FlatMapReaderTaskEither runs the computation returned by f for the value of the
+// computation, with the same environment. f is not called when the computation fails.
+func FlatMapReaderTaskEither[E any, A any, B any](r ReaderTaskEither[E, A], f func(A) ReaderTaskEither[E, B]) ReaderTaskEither[E, B] {
+	return ReaderTaskEither[E, B]{
+		run: func(env E) TaskEither[B] {
+			return FlatMapTaskEither(r.Provide(env), func(value A) TaskEither[B] {
+				return f(value).Provide(env)
+			})
+		},
+	}
+}
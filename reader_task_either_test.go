@@ -0,0 +1,133 @@
+package mo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testRepository is a fake dependency supplied through the environment.
+type testRepository struct {
+	users map[int]string
+}
+
+func (r testRepository) find(id int) (string, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return "", assert.AnError
+	}
+	return user, nil
+}
+
+func TestReaderTaskEither(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+
+	calls := 0
+	reader := NewReaderTaskEither(func(env testEnv) TaskEither[string] {
+		return NewTaskEitherFromFunc(func() (string, error) {
+			calls++
+			return env.prefix + "42", nil
+		})
+	})
+	task := reader.Provide(env)
+	is.Equal(0, calls)
+	value, err := task.Run().Collect()
+	is.NoError(err)
+	is.Equal("id-42", value)
+	is.Equal(1, calls)
+
+	// the TaskEither keeps its own options
+	is.PanicsWithValue("boom", func() {
+		NewReaderTaskEither(func(env testEnv) TaskEither[int] {
+			return NewTaskEither(func() *Future[int] {
+				panic("boom")
+			}, WithPanicRecovery(false))
+		}).Provide(env).Run()
+	})
+
+	is.Equal(Right[error, string]("id-"), NewReaderTaskEitherFromReader(Asks(func(env testEnv) string {
+		return env.prefix
+	})).Provide(env).ToEither())
+	is.Equal(Right[error, int](2), NewReaderTaskEitherFromReaderIOEither(AsksReaderIOEither(func(env testEnv) int {
+		return env.factor
+	})).Provide(env).ToEither())
+	is.Equal(Left[error, int](assert.AnError), NewReaderTaskEitherFromTaskEither[testEnv](NewTaskEitherFromResult(Err[int](assert.AnError))).Provide(env).ToEither())
+	is.Equal(Right[error, testEnv](env), AskReaderTaskEither[testEnv]().Provide(env).ToEither())
+	is.Equal(Right[error, int](2), AsksReaderTaskEither(func(env testEnv) int {
+		return env.factor
+	}).Provide(env).ToEither())
+}
+
+func TestReaderTaskEitherMethods(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+	prefix := AsksReaderTaskEither(func(env testEnv) string {
+		return env.prefix
+	})
+
+	is.Equal(Right[error, string]("user-"), prefix.Local(func(env testEnv) testEnv {
+		env.prefix = "user-"
+		return env
+	}).Provide(env).ToEither())
+	is.Equal(Right[error, string]("id-!"), prefix.Map(func(result string) string {
+		return result + "!"
+	}).Provide(env).ToEither())
+	is.Equal(Right[error, string]("id-2"), prefix.FlatMap(func(result string) ReaderTaskEither[testEnv, string] {
+		return AsksReaderTaskEither(func(env testEnv) string {
+			return result + strconv.Itoa(env.factor)
+		})
+	}).Provide(env).ToEither())
+}
+
+func TestReaderTaskEitherComposition(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+	factor := AsksReaderTaskEither(func(env testEnv) int {
+		return env.factor
+	})
+	failing := NewReaderTaskEitherFromTaskEither[testEnv](NewTaskEitherFromResult(Err[int](assert.AnError)))
+
+	is.Equal(Right[error, string]("2"), MapReaderTaskEither(factor, strconv.Itoa).Provide(env).ToEither())
+	is.Equal(Left[error, string](assert.AnError), MapReaderTaskEither(failing, strconv.Itoa).Provide(env).ToEither())
+
+	is.Equal(Right[error, int](42), FlatMapReaderTaskEither(factor, func(result int) ReaderTaskEither[testEnv, int] {
+		return AsksReaderTaskEither(func(env testEnv) int {
+			return result * 21
+		})
+	}).Provide(env).ToEither())
+	is.Equal(Left[error, int](assert.AnError), FlatMapReaderTaskEither(failing, func(result int) ReaderTaskEither[testEnv, int] {
+		is.Fail("should not enter here")
+		return factor
+	}).Provide(env).ToEither())
+
+	is.Equal(Right[error, int](2), LocalReaderTaskEither(AskReaderTaskEither[int](), func(env testEnv) int {
+		return env.factor
+	}).Provide(env).ToEither())
+}
+
+func TestReaderTaskEitherFakeEnvironment(t *testing.T) {
+	is := assert.New(t)
+
+	getUser := func(id int) ReaderTaskEither[testRepository, string] {
+		return NewReaderTaskEither(func(repository testRepository) TaskEither[string] {
+			return NewTaskEitherFromFunc(func() (string, error) {
+				return repository.find(id)
+			})
+		})
+	}
+	greet := func(id int) ReaderTaskEither[testRepository, string] {
+		return MapReaderTaskEither(getUser(id), func(user string) string {
+			return "hello " + user
+		})
+	}
+
+	fake := testRepository{users: map[int]string{1: "alice"}}
+
+	is.Equal(Right[error, string]("hello alice"), greet(1).Provide(fake).ToEither())
+	is.Equal(Left[error, string](assert.AnError), greet(2).Provide(fake).ToEither())
+}
@@ -0,0 +1,70 @@
+package mo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEnv struct {
+	prefix string
+	factor int
+}
+
+func TestReader(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+
+	reader := NewReader(func(env testEnv) string {
+		return env.prefix + "42"
+	})
+	is.Equal("id-42", reader.Run(env))
+	is.Equal("foo", ReturnReader[testEnv]("foo").Run(env))
+	is.Equal(env, Ask[testEnv]().Run(env))
+	is.Equal(2, Asks(func(env testEnv) int {
+		return env.factor
+	}).Run(env))
+}
+
+func TestReaderMethods(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+	prefix := Asks(func(env testEnv) string {
+		return env.prefix
+	})
+
+	is.Equal("user-", prefix.Local(func(env testEnv) testEnv {
+		env.prefix = "user-"
+		return env
+	}).Run(env))
+	is.Equal("id-!", prefix.Map(func(result string) string {
+		return result + "!"
+	}).Run(env))
+	is.Equal("id-2", prefix.FlatMap(func(result string) Reader[testEnv, string] {
+		return Asks(func(env testEnv) string {
+			return result + strconv.Itoa(env.factor)
+		})
+	}).Run(env))
+}
+
+func TestReaderComposition(t *testing.T) {
+	is := assert.New(t)
+
+	env := testEnv{prefix: "id-", factor: 2}
+	factor := Asks(func(env testEnv) int {
+		return env.factor
+	})
+
+	is.Equal("2", MapReader(factor, strconv.Itoa).Run(env))
+	is.Equal(42, FlatMapReader(factor, func(result int) Reader[testEnv, int] {
+		return Asks(func(env testEnv) int {
+			return result * 21
+		})
+	}).Run(env))
+	is.Equal(2, LocalReader(Ask[int](), func(env testEnv) int {
+		return env.factor
+	}).Run(env))
+}
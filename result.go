@@ -1,5 +1,13 @@
 package mo
 
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
 // Ok builds a Result when value is valid.
 // Play: https://go.dev/play/p/PDwADdzNoyZ
 func Ok[T any](value T) Result[T] {
@@ -153,3 +161,128 @@ func (r Result[T]) FlatMap(mapper func(value T) Result[T]) Result[T] {
 
 	return Err[T](r.err)
 }
+
+// MarshalJSON encodes Result into json, as `{"ok":value}` or `{"error":error}`. The error
+// is encoded with DefaultErrorCodec.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	return r.MarshalJSONWith(DefaultErrorCodec)
+}
+
+// UnmarshalJSON decodes Result from json. The error is decoded with DefaultErrorCodec.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	return r.UnmarshalJSONWith(data, DefaultErrorCodec)
+}
+
+// MarshalJSONWith encodes Result into json, as `{"ok":value}` or `{"error":error}`. The error
+// is encoded with codec.
+func (r Result[T]) MarshalJSONWith(codec ErrorCodec) ([]byte, error) {
+	if !r.isErr {
+		return json.Marshal(map[string]any{"ok": r.value})
+	}
+
+	err, encodeErr := codec.EncodeError(r.err)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	return json.Marshal(map[string]json.RawMessage{"error": err})
+}
+
+// UnmarshalJSONWith decodes Result from json. The error is decoded with codec.
+func (r *Result[T]) UnmarshalJSONWith(data []byte, codec ErrorCodec) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	value, isOk := fields["ok"]
+	rawErr, isErr := fields["error"]
+	if isOk == isErr {
+		return fmt.Errorf("Result[T].UnmarshalJSON: expected either \"ok\" or \"error\" field")
+	}
+
+	if isErr {
+		err, decodeErr := codec.DecodeError(rawErr)
+		if decodeErr != nil {
+			return decodeErr
+		}
+		*r = Err[T](err)
+		return nil
+	}
+
+	var result T
+	if err := json.Unmarshal(value, &result); err != nil {
+		return err
+	}
+	*r = Ok(result)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r Result[T]) MarshalText() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (r *Result[T]) UnmarshalText(data []byte) error {
+	return json.Unmarshal(data, r)
+}
+
+// BinaryMarshaler is the interface implemented by an object that can marshal itself into a binary form.
+// The error is encoded with DefaultErrorCodec.
+func (r Result[T]) MarshalBinary() ([]byte, error) {
+	if r.isErr {
+		err, encodeErr := DefaultErrorCodec.EncodeError(r.err)
+		if encodeErr != nil {
+			return []byte{}, encodeErr
+		}
+		return append([]byte{0}, err...), nil
+	}
+
+	var buf bytes.Buffer
+
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(r.value); err != nil {
+		return []byte{}, err
+	}
+
+	return append([]byte{1}, buf.Bytes()...), nil
+}
+
+// BinaryUnmarshaler is the interface implemented by an object that can unmarshal a binary representation of itself.
+// The error is decoded with DefaultErrorCodec.
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("Result[T].UnmarshalBinary: no data")
+	}
+
+	if data[0] == 0 {
+		err, decodeErr := DefaultErrorCodec.DecodeError(data[1:])
+		if decodeErr != nil {
+			return decodeErr
+		}
+		*r = Err[T](err)
+		return nil
+	}
+
+	var value T
+
+	buf := bytes.NewBuffer(data[1:])
+	dec := gob.NewDecoder(buf)
+	err := dec.Decode(&value)
+	if err != nil {
+		return err
+	}
+
+	*r = Ok(value)
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (r Result[T]) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (r *Result[T]) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
@@ -0,0 +1,53 @@
+package result
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/tperdue321/mo/lawtest"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+var errs = []error{errors.New("foo"), errors.New("bar")}
+
+func genInt(r *rand.Rand) int {
+	return r.Intn(200) - 100
+}
+
+func genResult(r *rand.Rand) Result[int] {
+	if r.Intn(4) == 0 {
+		return Err[int](errs[r.Intn(len(errs))])
+	}
+	return Ok(genInt(r))
+}
+
+func TestResultLaws(t *testing.T) {
+	lawtest.Monad[int](t, lawtest.MonadLaws[int, Result[int], Result[func(int) int]]{
+		Instance: Monad[int, int](),
+		OfFunc:   Monad[func(int) int, func(int) int](),
+		GenA:     genInt,
+		GenFA:    genResult,
+		GenFunc: func(r *rand.Rand) func(int) int {
+			n := genInt(r)
+			return func(i int) int { return i*2 + n }
+		},
+		GenKleisli: func(r *rand.Rand) func(int) Result[int] {
+			n := genInt(r)
+			err := errs[r.Intn(len(errs))]
+			return func(i int) Result[int] {
+				if (i+n)%3 == 0 {
+					return Err[int](err)
+				}
+				return Ok(i - n)
+			}
+		},
+		Equal: lawtest.DeepEqual[Result[int]](),
+	})
+
+	lawtest.Monoid(t, lawtest.MonoidLaws[Result[int]]{
+		Instance: Monoid[int](typeclass.SumMonoid[int]()),
+		Gen:      genResult,
+		Equal:    lawtest.DeepEqual[Result[int]](),
+	})
+}
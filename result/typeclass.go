@@ -0,0 +1,127 @@
+package result
+
+import "github.com/tperdue321/mo/typeclass"
+
+// Monad returns the Functor, Applicative and Monad instance of Result.
+func Monad[A, B any]() typeclass.Monad[A, B, Result[A], Result[B], Result[func(A) B]] {
+	return resultInstance[A, B]{}
+}
+
+// Foldable returns the Foldable instance of Result. Errors are folded as empty.
+func Foldable[A, B any]() typeclass.Foldable[A, B, Result[A]] {
+	return resultInstance[A, B]{}
+}
+
+// Filterable returns the Filterable instance of Result. Valid values not matching
+// the predicate are turned into the error built by onFalse.
+func Filterable[A any](onFalse func(A) error) typeclass.Filterable[A, Result[A]] {
+	return resultFilterable[A]{onFalse: onFalse}
+}
+
+// Traversable returns the Traversable instance of Result, running effects of the
+// applicative `G` described by pointed and functor.
+func Traversable[A, B, GB, GFB any](pointed typeclass.Pointed[Result[B], GFB], functor typeclass.Functor[B, Result[B], GB, GFB]) typeclass.Traversable[A, B, Result[A], GB, GFB] {
+	return resultTraversable[A, B, GB, GFB]{
+		pointed: pointed,
+		functor: functor,
+	}
+}
+
+// Semigroup returns the Semigroup of Result, combining valid values with the given Semigroup.
+// The first error wins.
+func Semigroup[T any](semigroup typeclass.Semigroup[T]) typeclass.Semigroup[Result[T]] {
+	return resultSemigroup[T]{semigroup: semigroup}
+}
+
+// Monoid returns the Monoid of Result, combining valid values with the given Monoid.
+// The first error wins and Ok of the identity element is the identity element.
+func Monoid[T any](monoid typeclass.Monoid[T]) typeclass.Monoid[Result[T]] {
+	return resultMonoid[T]{
+		resultSemigroup: resultSemigroup[T]{semigroup: monoid},
+		empty:           monoid.Empty(),
+	}
+}
+
+type resultInstance[A, B any] struct{}
+
+func (resultInstance[A, B]) Of(a A) Result[A] {
+	return Ok(a)
+}
+
+func (resultInstance[A, B]) Map(fa Result[A], f func(A) B) Result[B] {
+	if fa.IsError() {
+		return Err[B](fa.Error())
+	}
+	return Ok(f(fa.Get()))
+}
+
+func (i resultInstance[A, B]) Ap(fab Result[func(A) B], fa Result[A]) Result[B] {
+	if fab.IsError() {
+		return Err[B](fab.Error())
+	}
+	return i.Map(fa, fab.Get())
+}
+
+func (resultInstance[A, B]) FlatMap(fa Result[A], f func(A) Result[B]) Result[B] {
+	return WrapResult[A, B](fa).FlatMap(f)
+}
+
+func (resultInstance[A, B]) FoldLeft(fa Result[A], initial B, f func(B, A) B) B {
+	if fa.IsOk() {
+		return f(initial, fa.Get())
+	}
+	return initial
+}
+
+func (resultInstance[A, B]) FoldRight(fa Result[A], initial B, f func(A, B) B) B {
+	if fa.IsOk() {
+		return f(fa.Get(), initial)
+	}
+	return initial
+}
+
+type resultFilterable[A any] struct {
+	onFalse func(A) error
+}
+
+func (f resultFilterable[A]) Filter(fa Result[A], predicate func(A) bool) Result[A] {
+	if fa.IsOk() && !predicate(fa.Get()) {
+		return Err[A](f.onFalse(fa.Get()))
+	}
+	return fa
+}
+
+type resultTraversable[A, B, GB, GFB any] struct {
+	pointed typeclass.Pointed[Result[B], GFB]
+	functor typeclass.Functor[B, Result[B], GB, GFB]
+}
+
+func (t resultTraversable[A, B, GB, GFB]) Traverse(fa Result[A], f func(A) GB) GFB {
+	if fa.IsError() {
+		return t.pointed.Of(Err[B](fa.Error()))
+	}
+	return t.functor.Map(f(fa.Get()), Ok[B])
+}
+
+type resultSemigroup[T any] struct {
+	semigroup typeclass.Semigroup[T]
+}
+
+func (s resultSemigroup[T]) Concat(x Result[T], y Result[T]) Result[T] {
+	if x.IsError() {
+		return x
+	}
+	if y.IsError() {
+		return y
+	}
+	return Ok(s.semigroup.Concat(x.Get(), y.Get()))
+}
+
+type resultMonoid[T any] struct {
+	resultSemigroup[T]
+	empty T
+}
+
+func (m resultMonoid[T]) Empty() Result[T] {
+	return Ok(m.empty)
+}
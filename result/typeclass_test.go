@@ -0,0 +1,100 @@
+package result
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo/option"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+func double[FA, FB any](functor typeclass.Functor[int, int, FA, FB], fa FA) FB {
+	return functor.Map(fa, func(i int) int {
+		return i * 2
+	})
+}
+
+func TestResultMonad(t *testing.T) {
+	is := assert.New(t)
+
+	m := Monad[int, string]()
+
+	is.Equal(Ok(42), m.Of(42))
+	is.Equal(Ok("42"), m.Map(Ok(42), strconv.Itoa))
+	is.Equal(Err[string](assert.AnError), m.Map(Err[int](assert.AnError), strconv.Itoa))
+	is.Equal(Ok("42"), m.Ap(Ok(strconv.Itoa), Ok(42)))
+	is.Equal(Err[string](assert.AnError), m.Ap(Err[func(int) string](assert.AnError), Ok(42)))
+	is.Equal(Err[string](assert.AnError), m.Ap(Ok(strconv.Itoa), Err[int](assert.AnError)))
+	is.Equal(Ok("42"), m.FlatMap(Ok(42), func(i int) Result[string] {
+		return Ok(strconv.Itoa(i))
+	}))
+	is.Equal(Err[string](assert.AnError), m.FlatMap(Ok(42), func(i int) Result[string] {
+		return Err[string](assert.AnError)
+	}))
+
+	is.Equal(Ok(84), double[Result[int], Result[int]](Monad[int, int](), Ok(42)))
+}
+
+func TestResultFoldable(t *testing.T) {
+	is := assert.New(t)
+
+	f := Foldable[int, string]()
+
+	is.Equal("foo42", f.FoldLeft(Ok(42), "foo", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	}))
+	is.Equal("42foo", f.FoldRight(Ok(42), "foo", func(i int, acc string) string {
+		return strconv.Itoa(i) + acc
+	}))
+	is.Equal("foo", f.FoldLeft(Err[int](assert.AnError), "foo", func(acc string, i int) string {
+		return acc + strconv.Itoa(i)
+	}))
+}
+
+func TestResultFilterable(t *testing.T) {
+	is := assert.New(t)
+
+	f := Filterable(func(i int) error {
+		return assert.AnError
+	})
+	isEven := func(i int) bool {
+		return i%2 == 0
+	}
+
+	is.Equal(Ok(42), f.Filter(Ok(42), isEven))
+	is.Equal(Err[int](assert.AnError), f.Filter(Ok(21), isEven))
+	is.Equal(Err[int](assert.AnError), f.Filter(Err[int](assert.AnError), isEven))
+}
+
+func TestResultTraversable(t *testing.T) {
+	is := assert.New(t)
+
+	tr := Traversable[string, int, option.Option[int], option.Option[Result[int]]](
+		option.Monad[Result[int], Result[int]](),
+		option.Monad[int, Result[int]](),
+	)
+	parse := func(s string) option.Option[int] {
+		if v, err := strconv.Atoi(s); err == nil {
+			return option.Some(v)
+		}
+		return option.None[int]()
+	}
+
+	is.Equal(option.Some(Ok(42)), tr.Traverse(Ok("42"), parse))
+	is.Equal(option.None[Result[int]](), tr.Traverse(Ok("foo"), parse))
+	is.Equal(option.Some(Err[int](assert.AnError)), tr.Traverse(Err[string](assert.AnError), parse))
+}
+
+func TestResultSemigroupAndMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	s := Semigroup[int](typeclass.SumMonoid[int]())
+	m := Monoid[int](typeclass.SumMonoid[int]())
+
+	is.Equal(Ok(63), s.Concat(Ok(21), Ok(42)))
+	is.Equal(Ok(0), m.Empty())
+	is.Equal(Ok(63), m.Concat(Ok(21), Ok(42)))
+	is.Equal(Err[int](assert.AnError), m.Concat(Err[int](assert.AnError), Ok(42)))
+	is.Equal(Err[int](assert.AnError), m.Concat(Ok(21), Err[int](assert.AnError)))
+}
@@ -1,6 +1,9 @@
 package mo
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -195,3 +198,99 @@ func TestResultFlatMap(t *testing.T) {
 	is.Equal(Result[int]{value: 42, isErr: false, err: nil}, opt1)
 	is.Equal(Result[int]{value: 0, isErr: true, err: assert.AnError}, opt2)
 }
+
+func TestResultMarshalJSON(t *testing.T) {
+	is := assert.New(t)
+
+	value, err := json.Marshal(Ok(42))
+	is.NoError(err)
+	is.Equal(`{"ok":42}`, string(value))
+
+	value, err = json.Marshal(Err[int](assert.AnError))
+	is.NoError(err)
+	is.Equal(`{"error":"assert.AnError general error for testing"}`, string(value))
+
+	type testStruct struct {
+		Field Result[string] `json:"field"`
+	}
+	value, err = json.Marshal(testStruct{Field: Ok("foo")})
+	is.NoError(err)
+	is.Equal(`{"field":{"ok":"foo"}}`, string(value))
+}
+
+func TestResultUnmarshalJSON(t *testing.T) {
+	is := assert.New(t)
+
+	var result Result[int]
+
+	is.NoError(json.Unmarshal([]byte(`{"ok":42}`), &result))
+	is.Equal(Ok(42), result)
+
+	is.NoError(json.Unmarshal([]byte(`{"error":"failure"}`), &result))
+	is.True(result.IsError())
+	is.EqualError(result.Error(), "failure")
+
+	is.NoError(json.Unmarshal([]byte(`{"ok":null}`), &result))
+	is.Equal(Ok(0), result)
+
+	err := json.Unmarshal([]byte(`{}`), &result)
+	is.EqualError(err, `Result[T].UnmarshalJSON: expected either "ok" or "error" field`)
+
+	err = json.Unmarshal([]byte(`{"ok":1,"error":"failure"}`), &result)
+	is.EqualError(err, `Result[T].UnmarshalJSON: expected either "ok" or "error" field`)
+
+	err = json.Unmarshal([]byte(`{"ok":"foo"}`), &result)
+	is.Error(err)
+
+	err = json.Unmarshal([]byte(`{"error":42}`), &result)
+	is.Error(err)
+}
+
+func TestResultMarshalText(t *testing.T) {
+	is := assert.New(t)
+
+	value, err := Ok("foo").MarshalText()
+	is.NoError(err)
+	is.Equal(`{"ok":"foo"}`, string(value))
+
+	var result Result[string]
+	is.NoError(result.UnmarshalText(value))
+	is.Equal(Ok("foo"), result)
+}
+
+func TestResultMarshalBinary(t *testing.T) {
+	is := assert.New(t)
+
+	value, err := Ok("foo").MarshalBinary()
+	is.NoError(err)
+
+	var result Result[string]
+	is.NoError(result.UnmarshalBinary(value))
+	is.Equal(Ok("foo"), result)
+
+	value, err = Err[string](assert.AnError).MarshalBinary()
+	is.NoError(err)
+
+	is.NoError(result.UnmarshalBinary(value))
+	is.True(result.IsError())
+	is.EqualError(result.Error(), assert.AnError.Error())
+
+	is.EqualError(result.UnmarshalBinary([]byte{}), "Result[T].UnmarshalBinary: no data")
+}
+
+func TestResultGob(t *testing.T) {
+	is := assert.New(t)
+
+	type testStruct struct {
+		Ok  Result[int]
+		Err Result[int]
+	}
+
+	var buf bytes.Buffer
+	is.NoError(gob.NewEncoder(&buf).Encode(testStruct{Ok: Ok(42), Err: Err[int](assert.AnError)}))
+
+	var decoded testStruct
+	is.NoError(gob.NewDecoder(&buf).Decode(&decoded))
+	is.Equal(Ok(42), decoded.Ok)
+	is.EqualError(decoded.Err.Error(), assert.AnError.Error())
+}
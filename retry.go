@@ -0,0 +1,140 @@
+package mo
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Clock abstracts the passing of time, so that retries can be tested without sleeping.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Backoff returns the delay to wait before retrying after the given failed attempt, starting at 1.
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff waits the same delay between attempts.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff waits initial after the first attempt, then multiplies the delay by
+// multiplier after each attempt. The delay is capped by max, unless max is zero.
+func ExponentialBackoff(initial time.Duration, multiplier float64, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+		if max > 0 && delay > float64(max) {
+			return max
+		}
+		if delay > math.MaxInt64 {
+			return time.Duration(math.MaxInt64)
+		}
+		return time.Duration(delay)
+	}
+}
+
+// JitterBackoff randomizes the delays of backoff, removing up to factor of each delay.
+// A factor of 1 gives full jitter, between zero and the delay.
+func JitterBackoff(backoff Backoff, factor float64) Backoff {
+	return func(attempt int) time.Duration {
+		delay := backoff(attempt)
+		return delay - time.Duration(float64(delay)*factor*rand.Float64())
+	}
+}
+
+// RetryPolicy configures how Retry and RetryTask re-run a failing task.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Attempts are unlimited when it is zero.
+	MaxAttempts int
+	// Backoff computes the delay between attempts. Attempts are chained without delay when nil.
+	Backoff Backoff
+	// Retryable reports whether an error should be retried. Every error is retried when nil.
+	Retryable func(error) bool
+	// Deadline bounds the overall duration of the attempts and delays, unless it is zero.
+	// An attempt still running at the deadline is cancelled and ErrFutureTimeout is returned.
+	Deadline time.Duration
+	// Clock measures time. It defaults to the system clock.
+	Clock Clock
+}
+
+// Retry returns a TaskEither running task until it succeeds or the policy gives up,
+// in which case it fails with the last error. The task is run again on every attempt.
+func Retry[R any](task TaskEither[R], policy RetryPolicy) TaskEither[R] {
+	return TaskEither[R]{RetryTask(task.Task, policy)}
+}
+
+// RetryTask returns a Task running task until its Future resolves or the policy gives up,
+// in which case it is rejected with the last error. The task is run again on every attempt.
+// The returned Task runs with the options of task.
+func RetryTask[R any](task Task[R], policy RetryPolicy) Task[R] {
+	clock := policy.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	return deriveTask(task, func(task Task[R]) *Future[R] {
+		return newFutureWithOptions(func(resolve func(R), reject func(error)) {
+			var deadline <-chan time.Time
+			var deadlineAt time.Time
+			if policy.Deadline > 0 {
+				deadlineAt = clock.Now().Add(policy.Deadline)
+				deadline = clock.After(policy.Deadline)
+			}
+
+			for attempt := 1; ; attempt++ {
+				attemptFuture := task.Run()
+
+				var err error
+				select {
+				case <-attemptFuture.done:
+					var value R
+					value, err = attemptFuture.Collect()
+					if err == nil {
+						resolve(value)
+						return
+					}
+				case <-deadline:
+					attemptFuture.Cancel()
+					reject(ErrFutureTimeout)
+					return
+				}
+
+				if policy.Retryable != nil && !policy.Retryable(err) {
+					reject(err)
+					return
+				}
+				if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+					reject(err)
+					return
+				}
+
+				var delay time.Duration
+				if policy.Backoff != nil {
+					delay = policy.Backoff(attempt)
+				}
+				if deadline != nil && clock.Now().Add(delay).After(deadlineAt) {
+					reject(err)
+					return
+				}
+				if delay > 0 {
+					<-clock.After(delay)
+				}
+			}
+		}, task.options)
+	})
+}
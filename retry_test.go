@@ -0,0 +1,236 @@
+package mo
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock only moves forward on Advance. Durations passed to After are sent to
+// afters, so that tests know when the retried task is waiting.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeClockWaiter
+	afters  chan time.Duration
+}
+
+type fakeClockWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		afters: make(chan time.Duration, 16),
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	c.waiters = append(c.waiters, fakeClockWaiter{at: c.now.Add(d), ch: ch})
+	c.afters <- d
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	waiters := c.waiters[:0]
+	for _, waiter := range c.waiters {
+		if waiter.at.After(c.now) {
+			waiters = append(waiters, waiter)
+			continue
+		}
+		waiter.ch <- c.now
+	}
+	c.waiters = waiters
+}
+
+// failingTaskEither fails with assert.AnError until it has been run successAt times.
+func failingTaskEither(attempts *int32, successAt int32) TaskEither[int] {
+	return NewTaskEither(func() *Future[int] {
+		attempt := atomic.AddInt32(attempts, 1)
+		return NewFuture(func(resolve func(int), reject func(error)) {
+			if attempt < successAt {
+				reject(assert.AnError)
+				return
+			}
+			resolve(42)
+		})
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	is := assert.New(t)
+
+	constant := ConstantBackoff(10 * time.Millisecond)
+	is.Equal(10*time.Millisecond, constant(1))
+	is.Equal(10*time.Millisecond, constant(5))
+
+	exponential := ExponentialBackoff(10*time.Millisecond, 2, 50*time.Millisecond)
+	is.Equal(10*time.Millisecond, exponential(1))
+	is.Equal(20*time.Millisecond, exponential(2))
+	is.Equal(40*time.Millisecond, exponential(3))
+	is.Equal(50*time.Millisecond, exponential(4))
+	is.Equal(50*time.Millisecond, exponential(100))
+
+	jitter := JitterBackoff(constant, 0.5)
+	for i := 1; i <= 100; i++ {
+		delay := jitter(i)
+		is.GreaterOrEqual(delay, 5*time.Millisecond)
+		is.LessOrEqual(delay, 10*time.Millisecond)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	is := assert.New(t)
+
+	clock := newFakeClock()
+	var attempts int32
+
+	task := Retry(failingTaskEither(&attempts, 3), RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     ExponentialBackoff(10*time.Millisecond, 2, 0),
+		Clock:       clock,
+	})
+	is.Equal(int32(0), atomic.LoadInt32(&attempts))
+
+	future := task.Run()
+
+	is.Equal(10*time.Millisecond, <-clock.afters)
+	clock.Advance(10 * time.Millisecond)
+	is.Equal(20*time.Millisecond, <-clock.afters)
+	clock.Advance(20 * time.Millisecond)
+
+	value, err := future.Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+	is.Equal(int32(3), atomic.LoadInt32(&attempts))
+
+	// the task is run again lazily
+	is.Equal(Right[error, int](42), task.ToEither())
+	is.Equal(int32(4), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	is := assert.New(t)
+
+	var attempts int32
+
+	_, err := Retry(failingTaskEither(&attempts, 10), RetryPolicy{
+		MaxAttempts: 3,
+	}).Run().Collect()
+
+	is.Equal(assert.AnError, err)
+	is.Equal(int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryRetryable(t *testing.T) {
+	is := assert.New(t)
+
+	var attempts int32
+
+	_, err := Retry(failingTaskEither(&attempts, 10), RetryPolicy{
+		MaxAttempts: 3,
+		Retryable: func(err error) bool {
+			return err != assert.AnError
+		},
+	}).Run().Collect()
+
+	is.Equal(assert.AnError, err)
+	is.Equal(int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryDeadline(t *testing.T) {
+	is := assert.New(t)
+
+	clock := newFakeClock()
+	var attempts int32
+
+	future := Retry(failingTaskEither(&attempts, 10), RetryPolicy{
+		Backoff:  ConstantBackoff(40 * time.Millisecond),
+		Deadline: 100 * time.Millisecond,
+		Clock:    clock,
+	}).Run()
+
+	is.Equal(100*time.Millisecond, <-clock.afters)
+	is.Equal(40*time.Millisecond, <-clock.afters)
+	clock.Advance(40 * time.Millisecond)
+	is.Equal(40*time.Millisecond, <-clock.afters)
+	clock.Advance(40 * time.Millisecond)
+
+	// the next delay would end after the deadline
+	_, err := future.Collect()
+	is.Equal(assert.AnError, err)
+	is.Equal(int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryDeadlineCancelsAttempt(t *testing.T) {
+	is := assert.New(t)
+
+	clock := newFakeClock()
+	cancelled := make(chan struct{})
+
+	task := NewTask(func() *Future[int] {
+		return NewFutureWithContext(context.Background(), func(ctx context.Context, resolve func(int), reject func(error)) {
+			<-ctx.Done()
+			close(cancelled)
+		})
+	})
+
+	future := RetryTask(task, RetryPolicy{
+		Deadline: 50 * time.Millisecond,
+		Clock:    clock,
+	}).Run()
+
+	is.Equal(50*time.Millisecond, <-clock.afters)
+	clock.Advance(50 * time.Millisecond)
+
+	_, err := future.Collect()
+	is.ErrorIs(err, ErrFutureTimeout)
+	<-cancelled
+}
+
+func TestRetryOptions(t *testing.T) {
+	is := assert.New(t)
+
+	pool := NewWorkerPool(1, 10)
+	defer pool.Close()
+
+	var attempts int32
+	task := NewTask(func() *Future[int] {
+		return NewFuture(func(resolve func(int), reject func(error)) {
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				reject(assert.AnError)
+				return
+			}
+			resolve(42)
+		})
+	}, WithExecutor(pool), WithPanicRecovery(false))
+
+	retried := RetryTask(task, RetryPolicy{MaxAttempts: 5})
+	is.Equal(task.options, retried.options)
+
+	// the attempts run inline, so that a single worker is enough
+	value, err := retried.Run().Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+	is.Equal(int32(3), atomic.LoadInt32(&attempts))
+}
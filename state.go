@@ -24,29 +24,150 @@ func (s State[S, A]) Run(state S) (A, S) {
 	return s.run(state)
 }
 
-// Get returns the current state.
+// Eval executes a computation in the State monad and returns its result.
+func (s State[S, A]) Eval(state S) A {
+	result, _ := s.run(state)
+	return result
+}
+
+// Exec executes a computation in the State monad and returns the final state.
+func (s State[S, A]) Exec(state S) S {
+	_, final := s.run(state)
+	return final
+}
+
+// Get returns the state after the computation.
 func (s State[S, A]) Get() State[S, S] {
 	return State[S, S]{
 		run: func(state S) (S, S) {
+			_, state = s.run(state)
 			return state, state
 		},
 	}
 }
 
-// Modify the state by applying a function to the current state.
+// Modify the state after the computation by applying a function to it. The result is kept.
 func (s State[S, A]) Modify(f func(state S) S) State[S, A] {
 	return State[S, A]{
 		run: func(state S) (A, S) {
-			return empty[A](), f(state)
+			result, state := s.run(state)
+			return result, f(state)
 		},
 	}
 }
 
-// Put set the state.
-func (s State[S, A]) Put(state S) State[S, A] {
+// Put set the state after the computation. The result is kept.
+func (s State[S, A]) Put(newState S) State[S, A] {
 	return State[S, A]{
 		run: func(state S) (A, S) {
-			return empty[A](), state
+			result, _ := s.run(state)
+			return result, newState
+		},
+	}
+}
+
+// Map applies a function to the result of the computation.
+func (s State[S, A]) Map(f func(A) A) State[S, A] {
+	return MapState(s, f)
+}
+
+// FlatMap runs the computation returned by f for the result of the computation.
+func (s State[S, A]) FlatMap(f func(A) State[S, A]) State[S, A] {
+	return FlatMapState(s, f)
+}
+
+// Then runs next on the state left by the computation, and yields the value of next.
+// The value of the computation is discarded.
+func (s State[S, A]) Then(next State[S, A]) State[S, A] {
+	return ThenState(s, next)
+}
+
+// GetState returns a computation yielding the current state.
+func GetState[S any]() State[S, S] {
+	return State[S, S]{
+		run: func(state S) (S, S) {
+			return state, state
+		},
+	}
+}
+
+// Gets returns a computation yielding the result of f applied to the current state.
+func Gets[S any, A any](f func(state S) A) State[S, A] {
+	return State[S, A]{
+		run: func(state S) (A, S) {
+			return f(state), state
+		},
+	}
+}
+
+// PutState returns a computation setting the state.
+func PutState[S any](newState S) State[S, struct{}] {
+	return State[S, struct{}]{
+		run: func(state S) (struct{}, S) {
+			return struct{}{}, newState
+		},
+	}
+}
+
+// ModifyState returns a computation applying a function to the current state.
+func ModifyState[S any](f func(state S) S) State[S, struct{}] {
+	return State[S, struct{}]{
+		run: func(state S) (struct{}, S) {
+			return struct{}{}, f(state)
+		},
+	}
+}
+
+// MapState applies a function to the result of the computation.
+func MapState[S any, A any, B any](s State[S, A], f func(A) B) State[S, B] {
+	return State[S, B]{
+		run: func(state S) (B, S) {
+			result, state := s.run(state)
+			return f(result), state
+		},
+	}
+}
+
+// FlatMapState runs the computation returned by f for the result of the computation.
+func FlatMapState[S any, A any, B any](s State[S, A], f func(A) State[S, B]) State[S, B] {
+	return State[S, B]{
+		run: func(state S) (B, S) {
+			result, state := s.run(state)
+			return f(result).run(state)
+		},
+	}
+}
+
+// ThenState runs next on the state left by s, and yields the value of next. The value of
+// s is discarded, so that next may yield another type.
+func ThenState[S any, A any, B any](s State[S, A], next State[S, B]) State[S, B] {
+	return State[S, B]{
+		run: func(state S) (B, S) {
+			_, state = s.run(state)
+			return next.run(state)
+		},
+	}
+}
+
+// SequenceState runs the computations in order, threading the state, and yields their results.
+func SequenceState[S any, A any](states []State[S, A]) State[S, []A] {
+	return TraverseState(states, func(s State[S, A]) State[S, A] {
+		return s
+	})
+}
+
+// TraverseState runs the computation returned by f for every item in order, threading
+// the state, and yields their results.
+func TraverseState[S any, A any, B any](items []A, f func(A) State[S, B]) State[S, []B] {
+	return State[S, []B]{
+		run: func(state S) ([]B, S) {
+			results := make([]B, 0, len(items))
+			for _, item := range items {
+				var result B
+				result, state = f(item).run(state)
+				results = append(results, result)
+			}
+			return results, state
 		},
 	}
 }
@@ -0,0 +1,169 @@
+package mo
+
+// NewStateEither instanciates a new StateEither. When f fails, the state it returns is
+// discarded and the state it received is kept.
+func NewStateEither[S any, A any](f func(state S) (A, S, error)) StateEither[S, A] {
+	return StateEither[S, A]{
+		state: NewState(func(state S) (Result[A], S) {
+			result, next, err := f(state)
+			if err != nil {
+				return Err[A](err), state
+			}
+			return Ok(result), next
+		}),
+	}
+}
+
+// ReturnStateEither instanciates a new StateEither yielding x and leaving the state unchanged.
+func ReturnStateEither[S any, A any](x A) StateEither[S, A] {
+	return StateEither[S, A]{
+		state: ReturnState[S](Ok(x)),
+	}
+}
+
+// FailStateEither instanciates a new StateEither failing with err and leaving the state unchanged.
+func FailStateEither[S any, A any](err error) StateEither[S, A] {
+	return StateEither[S, A]{
+		state: ReturnState[S](Err[A](err)),
+	}
+}
+
+// NewStateEitherFromState instanciates a new StateEither from a State that never fails.
+func NewStateEitherFromState[S any, A any](state State[S, A]) StateEither[S, A] {
+	return StateEither[S, A]{
+		state: MapState(state, Ok[A]),
+	}
+}
+
+// NewStateEitherFromIOEither instanciates a new StateEither running io and leaving the state unchanged.
+func NewStateEitherFromIOEither[S any, A any](io IOEither[A]) StateEither[S, A] {
+	return NewStateEither(func(state S) (A, S, error) {
+		result, err := io.run()
+		return result, state, err
+	})
+}
+
+// StateEither represents a function `(S) -> (A, S)` that can fail, where `S` is state, `A` is result.
+// It is a State yielding a Result, that skips the next computations after the first error
+// and keeps the last good state.
+type StateEither[S any, A any] struct {
+	state State[S, Result[A]]
+}
+
+// Run executes the computation. On failure, it returns the state before the failing step.
+func (s StateEither[S, A]) Run(state S) (A, S, error) {
+	result, state := s.state.Run(state)
+	value, err := result.Get()
+	return value, state, err
+}
+
+// ToState returns the underlying State yielding a Result.
+func (s StateEither[S, A]) ToState() State[S, Result[A]] {
+	return s.state
+}
+
+// ToIOEither returns an IOEither running the computation from state, and yielding the
+// result with the final state.
+func (s StateEither[S, A]) ToIOEither(state S) IOEither[Tuple2[A, S]] {
+	return NewIOEither(func() (Tuple2[A, S], error) {
+		result, final, err := s.Run(state)
+		if err != nil {
+			return empty[Tuple2[A, S]](), err
+		}
+		return T2(result, final), nil
+	})
+}
+
+// Map applies a function to the result of the computation.
+func (s StateEither[S, A]) Map(f func(A) A) StateEither[S, A] {
+	return MapStateEither(s, f)
+}
+
+// FlatMap runs the computation returned by f for the result of the computation.
+func (s StateEither[S, A]) FlatMap(f func(A) StateEither[S, A]) StateEither[S, A] {
+	return FlatMapStateEither(s, f)
+}
+
+// Then runs next on the state left by the computation once it succeeds, discarding its
+// value. A failure of the computation is returned without running next.
+func (s StateEither[S, A]) Then(next StateEither[S, A]) StateEither[S, A] {
+	return FlatMapStateEither(s, func(A) StateEither[S, A] {
+		return next
+	})
+}
+
+// MapError applies a function to the error of the computation. The error is kept when
+// f returns nil: MapError cannot turn a failure into a success, see OrElse.
+func (s StateEither[S, A]) MapError(f func(error) error) StateEither[S, A] {
+	return StateEither[S, A]{
+		state: s.state.Map(func(result Result[A]) Result[A] {
+			if result.IsError() {
+				if err := f(result.Error()); err != nil {
+					return Err[A](err)
+				}
+				return result
+			}
+			return result
+		}),
+	}
+}
+
+// OrElse runs the computation returned by f for the error of the computation, from the
+// last good state.
+func (s StateEither[S, A]) OrElse(f func(error) StateEither[S, A]) StateEither[S, A] {
+	return StateEither[S, A]{
+		state: s.state.FlatMap(func(result Result[A]) State[S, Result[A]] {
+			if result.IsError() {
+				return f(result.Error()).state
+			}
+			return ReturnState[S](result)
+		}),
+	}
+}
+
+// MapStateEither applies a function to the result of the computation.
+func MapStateEither[S any, A any, B any](s StateEither[S, A], f func(A) B) StateEither[S, B] {
+	return FlatMapStateEither(s, func(value A) StateEither[S, B] {
+		return ReturnStateEither[S](f(value))
+	})
+}
+
+// FlatMapStateEither runs the computation returned by f for the result of the computation.
+// f is not called when the computation fails.
+func FlatMapStateEither[S any, A any, B any](s StateEither[S, A], f func(A) StateEither[S, B]) StateEither[S, B] {
+	return StateEither[S, B]{
+		state: FlatMapState(s.state, func(result Result[A]) State[S, Result[B]] {
+			if result.IsError() {
+				return ReturnState[S](Err[B](result.Error()))
+			}
+			return f(result.MustGet()).state
+		}),
+	}
+}
+
+// SequenceStateEither runs the computations in order, threading the state, and yields
+// their results. It stops at the first error.
+func SequenceStateEither[S any, A any](states []StateEither[S, A]) StateEither[S, []A] {
+	return TraverseStateEither(states, func(s StateEither[S, A]) StateEither[S, A] {
+		return s
+	})
+}
+
+// TraverseStateEither runs the computation returned by f for every item in order,
+// threading the state, and yields their results. It stops at the first error.
+func TraverseStateEither[S any, A any, B any](items []A, f func(A) StateEither[S, B]) StateEither[S, []B] {
+	return StateEither[S, []B]{
+		state: NewState(func(state S) (Result[[]B], S) {
+			results := make([]B, 0, len(items))
+			for _, item := range items {
+				var result Result[B]
+				result, state = f(item).state.Run(state)
+				if result.IsError() {
+					return Err[[]B](result.Error()), state
+				}
+				results = append(results, result.MustGet())
+			}
+			return Ok(results), state
+		}),
+	}
+}
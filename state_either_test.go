@@ -0,0 +1,238 @@
+package mo
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errStackUnderflow = errors.New("stack underflow")
+
+func TestStateEither(t *testing.T) {
+	is := assert.New(t)
+
+	increment := NewStateEither(func(state int) (string, int, error) {
+		return strconv.Itoa(state), state + 1, nil
+	})
+
+	result, final, err := increment.Run(41)
+	is.NoError(err)
+	is.Equal("41", result)
+	is.Equal(42, final)
+
+	failing := NewStateEither(func(state int) (string, int, error) {
+		return "ignored", state + 100, assert.AnError
+	})
+
+	result, final, err = failing.Run(41)
+	is.Equal(assert.AnError, err)
+	is.Equal("", result)
+	is.Equal(41, final)
+
+	result, final, err = ReturnStateEither[int]("foo").Run(42)
+	is.NoError(err)
+	is.Equal("foo", result)
+	is.Equal(42, final)
+
+	result, final, err = FailStateEither[int, string](assert.AnError).Run(42)
+	is.Equal(assert.AnError, err)
+	is.Equal("", result)
+	is.Equal(42, final)
+}
+
+func TestStateEitherShortCircuit(t *testing.T) {
+	is := assert.New(t)
+
+	calls := 0
+	increment := NewStateEither(func(state int) (int, int, error) {
+		calls++
+		return state, state + 1, nil
+	})
+
+	result, final, err := increment.
+		Then(increment).
+		Then(FailStateEither[int, int](assert.AnError)).
+		Then(increment).
+		Run(0)
+	is.Equal(assert.AnError, err)
+	is.Equal(0, result)
+	is.Equal(2, final)
+	is.Equal(2, calls)
+
+	result, final, err = increment.FlatMap(func(result int) StateEither[int, int] {
+		return ReturnStateEither[int](result * 10)
+	}).Map(func(result int) int {
+		return result + 1
+	}).Run(4)
+	is.NoError(err)
+	is.Equal(41, result)
+	is.Equal(5, final)
+}
+
+func TestStateEitherErrors(t *testing.T) {
+	is := assert.New(t)
+
+	failing := FailStateEither[int, int](assert.AnError)
+
+	_, _, err := failing.MapError(func(err error) error {
+		return errStackUnderflow
+	}).Run(1)
+	is.Equal(errStackUnderflow, err)
+
+	// MapError cannot turn a failure into a success
+	_, _, err = failing.MapError(func(err error) error {
+		return nil
+	}).Run(1)
+	is.Equal(assert.AnError, err)
+
+	result, final, err := ReturnStateEither[int](42).MapError(func(err error) error {
+		is.Fail("should not enter here")
+		return err
+	}).Run(1)
+	is.NoError(err)
+	is.Equal(42, result)
+	is.Equal(1, final)
+
+	result, final, err = failing.OrElse(func(err error) StateEither[int, int] {
+		is.Equal(assert.AnError, err)
+		return NewStateEither(func(state int) (int, int, error) {
+			return -1, state * 10, nil
+		})
+	}).Run(2)
+	is.NoError(err)
+	is.Equal(-1, result)
+	is.Equal(20, final)
+}
+
+func TestStateEitherConversions(t *testing.T) {
+	is := assert.New(t)
+
+	state := NewStateEitherFromState(NewState(func(state int) (string, int) {
+		return strconv.Itoa(state), state + 1
+	}))
+	result, final, err := state.Run(41)
+	is.NoError(err)
+	is.Equal("41", result)
+	is.Equal(42, final)
+
+	got, final := FailStateEither[int, string](assert.AnError).ToState().Run(41)
+	is.Equal(Err[string](assert.AnError), got)
+	is.Equal(41, final)
+
+	got, final = state.ToState().Run(41)
+	is.Equal(Ok("41"), got)
+	is.Equal(42, final)
+
+	calls := 0
+	io := NewStateEitherFromIOEither[int](NewIOEither(func() (string, error) {
+		calls++
+		return "io", nil
+	}))
+	is.Equal(0, calls)
+	result, final, err = io.Run(42)
+	is.NoError(err)
+	is.Equal("io", result)
+	is.Equal(42, final)
+	is.Equal(1, calls)
+
+	_, final, err = NewStateEitherFromIOEither[int](NewIOEitherFromResult(Err[string](assert.AnError))).Run(42)
+	is.Equal(assert.AnError, err)
+	is.Equal(42, final)
+
+	is.Equal(Right[error, Tuple2[string, int]](T2("41", 42)), state.ToIOEither(41).Run())
+	is.Equal(Left[error, Tuple2[string, int]](assert.AnError), FailStateEither[int, string](assert.AnError).ToIOEither(41).Run())
+}
+
+func TestStateEitherComposition(t *testing.T) {
+	is := assert.New(t)
+
+	length := MapStateEither(ReturnStateEither[int]("hello"), func(result string) int {
+		return len(result)
+	})
+	result, final, err := length.Run(1)
+	is.NoError(err)
+	is.Equal(5, result)
+	is.Equal(1, final)
+
+	_, _, err = MapStateEither(FailStateEither[int, string](assert.AnError), func(result string) int {
+		is.Fail("should not enter here")
+		return len(result)
+	}).Run(1)
+	is.Equal(assert.AnError, err)
+
+	label, final, err := FlatMapStateEither(length, func(result int) StateEither[int, string] {
+		return NewStateEither(func(state int) (string, int, error) {
+			return strconv.Itoa(result + state), state + 1, nil
+		})
+	}).Run(1)
+	is.NoError(err)
+	is.Equal("6", label)
+	is.Equal(2, final)
+
+	increment := NewStateEither(func(state int) (int, int, error) {
+		return state, state + 1, nil
+	})
+	results, final, err := SequenceStateEither([]StateEither[int, int]{increment, increment, increment}).Run(10)
+	is.NoError(err)
+	is.Equal([]int{10, 11, 12}, results)
+	is.Equal(13, final)
+
+	results, final, err = SequenceStateEither([]StateEither[int, int]{increment, FailStateEither[int, int](assert.AnError), increment}).Run(10)
+	is.Equal(assert.AnError, err)
+	is.Nil(results)
+	is.Equal(11, final)
+
+	results, final, err = SequenceStateEither([]StateEither[int, int]{}).Run(10)
+	is.NoError(err)
+	is.Equal([]int{}, results)
+	is.Equal(10, final)
+}
+
+func TestStateEitherStackMachine(t *testing.T) {
+	is := assert.New(t)
+
+	pop := NewStateEither(func(stack []int) (int, []int, error) {
+		if len(stack) == 0 {
+			return 0, stack, errStackUnderflow
+		}
+		return stack[len(stack)-1], stack[:len(stack)-1], nil
+	})
+	push := func(value int) StateEither[[]int, int] {
+		return NewStateEither(func(stack []int) (int, []int, error) {
+			return value, append(stack, value), nil
+		})
+	}
+	add := FlatMapStateEither(pop, func(a int) StateEither[[]int, int] {
+		return FlatMapStateEither(pop, func(b int) StateEither[[]int, int] {
+			return push(a + b)
+		})
+	})
+
+	program := func(tokens []string) StateEither[[]int, []int] {
+		return TraverseStateEither(tokens, func(token string) StateEither[[]int, int] {
+			if token == "+" {
+				return add
+			}
+			value, err := strconv.Atoi(token)
+			if err != nil {
+				return FailStateEither[[]int, int](err)
+			}
+			return push(value)
+		})
+	}
+
+	results, stack, err := program([]string{"1", "2", "+", "4", "+"}).Run(nil)
+	is.NoError(err)
+	is.Equal([]int{1, 2, 3, 4, 7}, results)
+	is.Equal([]int{7}, stack)
+
+	_, stack, err = program([]string{"1", "+", "2"}).Run(nil)
+	is.Equal(errStackUnderflow, err)
+	is.Equal([]int{}, stack)
+
+	_, stack, err = program([]string{"1", "x"}).Run(nil)
+	is.Error(err)
+	is.Equal([]int{1}, stack)
+}
@@ -0,0 +1,142 @@
+package mo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState(t *testing.T) {
+	is := assert.New(t)
+
+	state := NewState(func(state int) (string, int) {
+		return strconv.Itoa(state), state + 1
+	})
+
+	result, final := state.Run(41)
+	is.Equal("41", result)
+	is.Equal(42, final)
+	is.Equal("41", state.Eval(41))
+	is.Equal(42, state.Exec(41))
+
+	result, final = ReturnState[int]("foo").Run(42)
+	is.Equal("foo", result)
+	is.Equal(42, final)
+}
+
+func TestStateMethods(t *testing.T) {
+	is := assert.New(t)
+
+	increment := NewState(func(state int) (string, int) {
+		return "incremented", state + 1
+	})
+
+	got, final := increment.Get().Run(1)
+	is.Equal(2, got)
+	is.Equal(2, final)
+
+	result, final := increment.Modify(func(state int) int {
+		return state * 10
+	}).Run(1)
+	is.Equal("incremented", result)
+	is.Equal(20, final)
+
+	result, final = increment.Put(42).Run(1)
+	is.Equal("incremented", result)
+	is.Equal(42, final)
+
+	result, final = increment.Map(func(result string) string {
+		return result + "!"
+	}).Run(1)
+	is.Equal("incremented!", result)
+	is.Equal(2, final)
+
+	result, final = increment.FlatMap(func(result string) State[int, string] {
+		return Gets(func(state int) string {
+			return result + " to " + strconv.Itoa(state)
+		})
+	}).Run(1)
+	is.Equal("incremented to 2", result)
+	is.Equal(2, final)
+
+	result, final = increment.Then(increment).Then(ReturnState[int]("done")).Run(1)
+	is.Equal("done", result)
+	is.Equal(3, final)
+}
+
+func TestStateConstructors(t *testing.T) {
+	is := assert.New(t)
+
+	got, final := GetState[int]().Run(42)
+	is.Equal(42, got)
+	is.Equal(42, final)
+
+	length, unchanged := Gets(func(state string) int {
+		return len(state)
+	}).Run("foo")
+	is.Equal(3, length)
+	is.Equal("foo", unchanged)
+
+	is.Equal(21, PutState(21).Exec(42))
+	is.Equal(84, ModifyState(func(state int) int {
+		return state * 2
+	}).Exec(42))
+}
+
+func TestStateTypeChanging(t *testing.T) {
+	is := assert.New(t)
+
+	counter := NewState(func(state int) (int, int) {
+		return state, state + 1
+	})
+
+	result, final := MapState(counter, strconv.Itoa).Run(1)
+	is.Equal("1", result)
+	is.Equal(2, final)
+
+	result, final = FlatMapState(counter, func(value int) State[int, string] {
+		return MapState(counter, func(next int) string {
+			return strconv.Itoa(value) + strconv.Itoa(next)
+		})
+	}).Run(1)
+	is.Equal("12", result)
+	is.Equal(3, final)
+
+	result, final = ThenState(PutState(10), MapState(counter, strconv.Itoa)).Run(1)
+	is.Equal("10", result)
+	is.Equal(11, final)
+
+	results, final := SequenceState([]State[int, int]{counter, counter, counter}).Run(1)
+	is.Equal([]int{1, 2, 3}, results)
+	is.Equal(4, final)
+}
+
+func TestStateInterpreter(t *testing.T) {
+	is := assert.New(t)
+
+	// a tiny stack machine: numbers are pushed, "+" pops two numbers and pushes their sum
+	push := func(value int) State[[]int, struct{}] {
+		return ModifyState(func(stack []int) []int {
+			return append(stack, value)
+		})
+	}
+	pop := NewState(func(stack []int) (int, []int) {
+		return stack[len(stack)-1], stack[:len(stack)-1]
+	})
+	step := func(token string) State[[]int, struct{}] {
+		if token != "+" {
+			value, _ := strconv.Atoi(token)
+			return push(value)
+		}
+		return FlatMapState(pop, func(a int) State[[]int, struct{}] {
+			return FlatMapState(pop, func(b int) State[[]int, struct{}] {
+				return push(a + b)
+			})
+		})
+	}
+
+	program := ThenState(TraverseState([]string{"1", "2", "+", "39", "+"}, step), pop)
+	is.Equal(42, program.Eval([]int{}))
+	is.Equal([]int{}, program.Exec([]int{}))
+}
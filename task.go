@@ -1,20 +1,22 @@
 package mo
 
 // NewTask instanciates a new Task.
-func NewTask[R any](f ff0[R]) Task[R] {
+func NewTask[R any](f ff0[R], opts ...RunOption) Task[R] {
 	return Task[R]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
 // NewTaskFromIO instanciates a new Task from an existing IO.
-func NewTaskFromIO[R any](io IO[R]) Task[R] {
+func NewTaskFromIO[R any](io IO[R], opts ...RunOption) Task[R] {
 	return Task[R]{
 		unsafePerform: func() *Future[R] {
-			return NewFuture[R](func(resolve func(R), reject func(error)) {
+			return newFutureWithOptions(func(resolve func(R), reject func(error)) {
 				resolve(io.unsafePerform())
-			})
+			}, newRunOptions(opts).inline())
 		},
+		options: newRunOptions(opts),
 	}
 }
 
@@ -22,28 +24,33 @@ func NewTaskFromIO[R any](io IO[R]) Task[R] {
 // can cause side effects, yields a value of type `R` and never fails.
 type Task[R any] struct {
 	unsafePerform ff0[R]
+	options       runOptions
 }
 
 // Run execute the non-deterministic asynchronous computation, with side effect.
 func (t Task[R]) Run() *Future[R] {
-	return t.unsafePerform()
+	return runTask(t.options, func() *Future[R] {
+		return t.unsafePerform()
+	})
 }
 
 // NewTask1 instanciates a new Task1.
-func NewTask1[R any, A any](f ff1[R, A]) Task1[R, A] {
+func NewTask1[R any, A any](f ff1[R, A], opts ...RunOption) Task1[R, A] {
 	return Task1[R, A]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
 // NewTaskFromIO1 instanciates a new Task1 from an existing IO1.
-func NewTaskFromIO1[R any, A any](io IO1[R, A]) Task1[R, A] {
+func NewTaskFromIO1[R any, A any](io IO1[R, A], opts ...RunOption) Task1[R, A] {
 	return Task1[R, A]{
 		unsafePerform: func(a A) *Future[R] {
-			return NewFuture[R](func(resolve func(R), reject func(error)) {
+			return newFutureWithOptions(func(resolve func(R), reject func(error)) {
 				resolve(io.unsafePerform(a))
-			})
+			}, newRunOptions(opts).inline())
 		},
+		options: newRunOptions(opts),
 	}
 }
 
@@ -51,28 +58,33 @@ func NewTaskFromIO1[R any, A any](io IO1[R, A]) Task1[R, A] {
 // can cause side effects, yields a value of type `R` and never fails.
 type Task1[R any, A any] struct {
 	unsafePerform ff1[R, A]
+	options       runOptions
 }
 
 // Run execute the non-deterministic asynchronous computation, with side effect.
 func (t Task1[R, A]) Run(a A) *Future[R] {
-	return t.unsafePerform(a)
+	return runTask(t.options, func() *Future[R] {
+		return t.unsafePerform(a)
+	})
 }
 
 // NewTask2 instanciates a new Task2.
-func NewTask2[R any, A any, B any](f ff2[R, A, B]) Task2[R, A, B] {
+func NewTask2[R any, A any, B any](f ff2[R, A, B], opts ...RunOption) Task2[R, A, B] {
 	return Task2[R, A, B]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
 // NewTaskFromIO2 instanciates a new Task2 from an existing IO2.
-func NewTaskFromIO2[R any, A any, B any](io IO2[R, A, B]) Task2[R, A, B] {
+func NewTaskFromIO2[R any, A any, B any](io IO2[R, A, B], opts ...RunOption) Task2[R, A, B] {
 	return Task2[R, A, B]{
 		unsafePerform: func(a A, b B) *Future[R] {
-			return NewFuture[R](func(resolve func(R), reject func(error)) {
+			return newFutureWithOptions(func(resolve func(R), reject func(error)) {
 				resolve(io.unsafePerform(a, b))
-			})
+			}, newRunOptions(opts).inline())
 		},
+		options: newRunOptions(opts),
 	}
 }
 
@@ -80,28 +92,33 @@ func NewTaskFromIO2[R any, A any, B any](io IO2[R, A, B]) Task2[R, A, B] {
 // can cause side effects, yields a value of type `R` and never fails.
 type Task2[R any, A any, B any] struct {
 	unsafePerform ff2[R, A, B]
+	options       runOptions
 }
 
 // Run execute the non-deterministic asynchronous computation, with side effect.
 func (t Task2[R, A, B]) Run(a A, b B) *Future[R] {
-	return t.unsafePerform(a, b)
+	return runTask(t.options, func() *Future[R] {
+		return t.unsafePerform(a, b)
+	})
 }
 
 // NewTask3 instanciates a new Task3.
-func NewTask3[R any, A any, B any, C any](f ff3[R, A, B, C]) Task3[R, A, B, C] {
+func NewTask3[R any, A any, B any, C any](f ff3[R, A, B, C], opts ...RunOption) Task3[R, A, B, C] {
 	return Task3[R, A, B, C]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
 // NewTaskFromIO3 instanciates a new Task3 from an existing IO3.
-func NewTaskFromIO3[R any, A any, B any, C any](io IO3[R, A, B, C]) Task3[R, A, B, C] {
+func NewTaskFromIO3[R any, A any, B any, C any](io IO3[R, A, B, C], opts ...RunOption) Task3[R, A, B, C] {
 	return Task3[R, A, B, C]{
 		unsafePerform: func(a A, b B, c C) *Future[R] {
-			return NewFuture[R](func(resolve func(R), reject func(error)) {
+			return newFutureWithOptions(func(resolve func(R), reject func(error)) {
 				resolve(io.unsafePerform(a, b, c))
-			})
+			}, newRunOptions(opts).inline())
 		},
+		options: newRunOptions(opts),
 	}
 }
 
@@ -109,28 +126,33 @@ func NewTaskFromIO3[R any, A any, B any, C any](io IO3[R, A, B, C]) Task3[R, A,
 // can cause side effects, yields a value of type `R` and never fails.
 type Task3[R any, A any, B any, C any] struct {
 	unsafePerform ff3[R, A, B, C]
+	options       runOptions
 }
 
 // Run execute the non-deterministic asynchronous computation, with side effect.
 func (t Task3[R, A, B, C]) Run(a A, b B, c C) *Future[R] {
-	return t.unsafePerform(a, b, c)
+	return runTask(t.options, func() *Future[R] {
+		return t.unsafePerform(a, b, c)
+	})
 }
 
 // NewTask4 instanciates a new Task4.
-func NewTask4[R any, A any, B any, C any, D any](f ff4[R, A, B, C, D]) Task4[R, A, B, C, D] {
+func NewTask4[R any, A any, B any, C any, D any](f ff4[R, A, B, C, D], opts ...RunOption) Task4[R, A, B, C, D] {
 	return Task4[R, A, B, C, D]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
 // NewTaskFromIO4 instanciates a new Task4 from an existing IO4.
-func NewTaskFromIO4[R any, A any, B any, C any, D any](io IO4[R, A, B, C, D]) Task4[R, A, B, C, D] {
+func NewTaskFromIO4[R any, A any, B any, C any, D any](io IO4[R, A, B, C, D], opts ...RunOption) Task4[R, A, B, C, D] {
 	return Task4[R, A, B, C, D]{
 		unsafePerform: func(a A, b B, c C, d D) *Future[R] {
-			return NewFuture[R](func(resolve func(R), reject func(error)) {
+			return newFutureWithOptions(func(resolve func(R), reject func(error)) {
 				resolve(io.unsafePerform(a, b, c, d))
-			})
+			}, newRunOptions(opts).inline())
 		},
+		options: newRunOptions(opts),
 	}
 }
 
@@ -138,28 +160,33 @@ func NewTaskFromIO4[R any, A any, B any, C any, D any](io IO4[R, A, B, C, D]) Ta
 // can cause side effects, yields a value of type `R` and never fails.
 type Task4[R any, A any, B any, C any, D any] struct {
 	unsafePerform ff4[R, A, B, C, D]
+	options       runOptions
 }
 
 // Run execute the non-deterministic asynchronous computation, with side effect.
 func (t Task4[R, A, B, C, D]) Run(a A, b B, c C, d D) *Future[R] {
-	return t.unsafePerform(a, b, c, d)
+	return runTask(t.options, func() *Future[R] {
+		return t.unsafePerform(a, b, c, d)
+	})
 }
 
 // NewTask5 instanciates a new Task5.
-func NewTask5[R any, A any, B any, C any, D any, E any](f ff5[R, A, B, C, D, E]) Task5[R, A, B, C, D, E] {
+func NewTask5[R any, A any, B any, C any, D any, E any](f ff5[R, A, B, C, D, E], opts ...RunOption) Task5[R, A, B, C, D, E] {
 	return Task5[R, A, B, C, D, E]{
 		unsafePerform: f,
+		options:       newRunOptions(opts),
 	}
 }
 
 // NewTaskFromIO5 instanciates a new Task5 from an existing IO5.
-func NewTaskFromIO5[R any, A any, B any, C any, D any, E any](io IO5[R, A, B, C, D, E]) Task5[R, A, B, C, D, E] {
+func NewTaskFromIO5[R any, A any, B any, C any, D any, E any](io IO5[R, A, B, C, D, E], opts ...RunOption) Task5[R, A, B, C, D, E] {
 	return Task5[R, A, B, C, D, E]{
 		unsafePerform: func(a A, b B, c C, d D, e E) *Future[R] {
-			return NewFuture[R](func(resolve func(R), reject func(error)) {
+			return newFutureWithOptions(func(resolve func(R), reject func(error)) {
 				resolve(io.unsafePerform(a, b, c, d, e))
-			})
+			}, newRunOptions(opts).inline())
 		},
+		options: newRunOptions(opts),
 	}
 }
 
@@ -167,9 +194,34 @@ func NewTaskFromIO5[R any, A any, B any, C any, D any, E any](io IO5[R, A, B, C,
 // can cause side effects, yields a value of type `R` and never fails.
 type Task5[R any, A any, B any, C any, D any, E any] struct {
 	unsafePerform ff5[R, A, B, C, D, E]
+	options       runOptions
 }
 
 // Run execute the non-deterministic asynchronous computation, with side effect.
 func (t Task5[R, A, B, C, D, E]) Run(a A, b B, c C, d D, e E) *Future[R] {
-	return t.unsafePerform(a, b, c, d, e)
+	return runTask(t.options, func() *Future[R] {
+		return t.unsafePerform(a, b, c, d, e)
+	})
+}
+
+// runTask runs perform, recovering its panics when enabled. When an executor is set,
+// the whole run, until the Future returned by perform is settled, happens on the executor.
+func runTask[R any](options runOptions, perform func() *Future[R]) (future *Future[R]) {
+	if options.executor != nil {
+		return newFutureWithOptions(func(resolve func(R), reject func(error)) {
+			value, err := perform().Collect()
+			if err != nil {
+				reject(err)
+				return
+			}
+			resolve(value)
+		}, options)
+	}
+
+	if options.recoverPanics {
+		defer recoverPanic(func(err error) {
+			future = newRejectedFuture[R](err)
+		})
+	}
+	return perform()
 }
@@ -0,0 +1,157 @@
+package mo
+
+import "sync"
+
+// Map returns a Task applying f to the value of t. Nothing runs until Run is called.
+func (t Task[R]) Map(f func(R) R) Task[R] {
+	return MapTask(t, f)
+}
+
+// FlatMap returns a Task running the Task returned by f for the value of t.
+func (t Task[R]) FlatMap(f func(R) Task[R]) Task[R] {
+	return FlatMapTask(t, f)
+}
+
+// Tap returns a Task calling f with the value of t, then yielding that value.
+func (t Task[R]) Tap(f func(R)) Task[R] {
+	return MapTask(t, func(value R) R {
+		f(value)
+		return value
+	})
+}
+
+// MapTask returns a Task applying f to the value of t. Nothing runs until Run is called.
+// The Task runs with the options of t.
+func MapTask[A any, B any](t Task[A], f func(A) B) Task[B] {
+	return deriveTask(t, func(t Task[A]) *Future[B] {
+		return thenFuture(t.Run(), func(value A) (B, error) {
+			return f(value), nil
+		})
+	})
+}
+
+// FlatMapTask returns a Task running the Task returned by f for the value of t.
+// The Task runs with the options of t.
+func FlatMapTask[A any, B any](t Task[A], f func(A) Task[B]) Task[B] {
+	return deriveTask(t, func(t Task[A]) *Future[B] {
+		return flatMapFuture(t.Run(), func(value A) *Future[B] {
+			return f(value).Run()
+		})
+	})
+}
+
+// deriveTask returns a Task with the options of t, running perform. perform receives t
+// without executor, since the derived Task already holds a worker of the executor for
+// the whole run: running t on it again could deadlock.
+func deriveTask[A any, B any](t Task[A], perform func(t Task[A]) *Future[B]) Task[B] {
+	inline := t
+	inline.options = t.options.inline()
+
+	return Task[B]{
+		unsafePerform: func() *Future[B] {
+			return perform(inline)
+		},
+		options: t.options,
+	}
+}
+
+// ZipTask returns a Task running a and b concurrently, and yielding both values.
+// a and b run with their own options.
+func ZipTask[A any, B any](a Task[A], b Task[B]) Task[Tuple2[A, B]] {
+	return NewTask(func() *Future[Tuple2[A, B]] {
+		return zipFutures(a.Run(), b.Run())
+	})
+}
+
+// SequenceTask returns a Task running every Task concurrently, and yielding their
+// values in order.
+func SequenceTask[R any](tasks []Task[R]) Task[[]R] {
+	return TraverseTask(tasks, func(t Task[R]) Task[R] {
+		return t
+	})
+}
+
+// TraverseTask returns a Task running the Task returned by f for every item concurrently,
+// and yielding their values in order. Each Task runs with its own options. See
+// TraverseTaskPar to bound the parallelism.
+func TraverseTask[A any, R any](items []A, f func(A) Task[R]) Task[[]R] {
+	return NewTask(func() *Future[[]R] {
+		futures := make([]*Future[R], 0, len(items))
+		for _, item := range items {
+			futures = append(futures, f(item).Run())
+		}
+		return All(futures...)
+	})
+}
+
+// TraverseTaskPar returns a Task running the Task returned by f for every item, with at most
+// parallelism Tasks in flight, or all of them when parallelism is zero. It yields their
+// values in order, or fails with the first error, in which case no more Task is started.
+func TraverseTaskPar[A any, R any](items []A, f func(A) Task[R], parallelism int) Task[[]R] {
+	return NewTask(func() *Future[[]R] {
+		return NewFuture(func(resolve func([]R), reject func(error)) {
+			workers := parallelism
+			if workers <= 0 || workers > len(items) {
+				workers = len(items)
+			}
+
+			values := make([]R, len(items))
+			indexes := make(chan int)
+
+			var mu sync.Mutex
+			var firstErr error
+			failed := func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return firstErr != nil
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(workers)
+			for i := 0; i < workers; i++ {
+				go func() {
+					defer wg.Done()
+
+					for index := range indexes {
+						if failed() {
+							continue
+						}
+
+						value, err := f(items[index]).Run().Collect()
+
+						mu.Lock()
+						if err != nil && firstErr == nil {
+							firstErr = err
+						}
+						values[index] = value
+						mu.Unlock()
+					}
+				}()
+			}
+
+			for index := range items {
+				if failed() {
+					break
+				}
+				indexes <- index
+			}
+			close(indexes)
+			wg.Wait()
+
+			if firstErr != nil {
+				reject(firstErr)
+				return
+			}
+			resolve(values)
+		})
+	})
+}
+
+// zipFutures returns a Future resolved with the values of a and b.
+func zipFutures[A any, B any](a *Future[A], b *Future[B]) *Future[Tuple2[A, B]] {
+	return flatMapFuture(a, func(valueA A) *Future[Tuple2[A, B]] {
+		return thenFuture(b, func(valueB B) (Tuple2[A, B], error) {
+			return T2(valueA, valueB), nil
+		})
+	})
+}
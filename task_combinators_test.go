@@ -0,0 +1,140 @@
+package mo
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTask[R any](value R) Task[R] {
+	return NewTask(func() *Future[R] {
+		return NewFuture(func(resolve func(R), reject func(error)) {
+			resolve(value)
+		})
+	})
+}
+
+func TestTaskCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	var runs int32
+	task := NewTask(func() *Future[int] {
+		atomic.AddInt32(&runs, 1)
+		return NewFuture(func(resolve func(int), reject func(error)) {
+			resolve(21)
+		})
+	})
+
+	var tapped int32
+	composed := task.
+		Map(func(value int) int {
+			return value * 2
+		}).
+		FlatMap(func(value int) Task[int] {
+			return newTestTask(value + 1)
+		}).
+		Tap(func(value int) {
+			atomic.StoreInt32(&tapped, int32(value))
+		})
+	is.Equal(int32(0), atomic.LoadInt32(&runs))
+
+	value, err := composed.Run().Collect()
+	is.NoError(err)
+	is.Equal(43, value)
+	is.Equal(int32(1), atomic.LoadInt32(&runs))
+	is.Equal(int32(43), atomic.LoadInt32(&tapped))
+}
+
+func TestTaskTypeChangingCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	task := newTestTask(42)
+
+	is.Equal(Ok("42"), MapTask(task, strconv.Itoa).Run().Result())
+	is.Equal(Ok("42"), FlatMapTask(task, func(value int) Task[string] {
+		return newTestTask(strconv.Itoa(value))
+	}).Run().Result())
+	is.Equal(Ok(T2(42, "foo")), ZipTask(task, newTestTask("foo")).Run().Result())
+	is.Equal(Ok([]int{1, 2}), SequenceTask([]Task[int]{newTestTask(1), newTestTask(2)}).Run().Result())
+	is.Equal(Ok([]string{"1", "2"}), TraverseTask([]int{1, 2}, func(value int) Task[string] {
+		return newTestTask(strconv.Itoa(value))
+	}).Run().Result())
+
+	panicking := NewTask(func() *Future[int] {
+		panic("boom")
+	})
+	_, err := MapTask(panicking, strconv.Itoa).Run().Collect()
+	is.IsType(&PanicError{}, err)
+}
+
+func TestTaskCombinatorsOptions(t *testing.T) {
+	is := assert.New(t)
+
+	pool := NewWorkerPool(1, 10)
+	defer pool.Close()
+
+	task := NewTask(func() *Future[int] {
+		return NewFuture(func(resolve func(int), reject func(error)) {
+			resolve(21)
+		})
+	}, WithExecutor(pool), WithPanicRecovery(false))
+
+	mapped := MapTask(task, strconv.Itoa)
+	is.Equal(task.options, mapped.options)
+	is.Equal(task.options, FlatMapTask(task, func(value int) Task[int] {
+		return newTestTask(value)
+	}).options)
+	is.Equal(task.options, task.Map(func(value int) int {
+		return value
+	}).options)
+
+	// the source runs inline, so that a single worker is enough
+	value, err := mapped.Run().Collect()
+	is.NoError(err)
+	is.Equal("21", value)
+}
+
+func TestTraverseTaskPar(t *testing.T) {
+	is := assert.New(t)
+
+	tracker := &concurrencyTracker{}
+	double := func(a int) Task[int] {
+		return NewTask(func() *Future[int] {
+			return NewFuture(func(resolve func(int), reject func(error)) {
+				tracker.enter()
+				time.Sleep(time.Millisecond)
+				tracker.leave()
+				resolve(a * 2)
+			})
+		})
+	}
+
+	task := TraverseTaskPar([]int{1, 2, 3, 4, 5, 6}, double, 2)
+	is.Equal(int32(0), atomic.LoadInt32(&tracker.max))
+
+	values, err := task.Run().Collect()
+	is.NoError(err)
+	is.Equal([]int{2, 4, 6, 8, 10, 12}, values)
+	is.Equal(int32(2), atomic.LoadInt32(&tracker.max))
+
+	values, err = TraverseTaskPar([]int{}, double, 2).Run().Collect()
+	is.NoError(err)
+	is.Equal([]int{}, values)
+
+	var runs int32
+	failing := func(a int) Task[int] {
+		return NewTask(func() *Future[int] {
+			atomic.AddInt32(&runs, 1)
+			return NewFuture(func(resolve func(int), reject func(error)) {
+				reject(assert.AnError)
+			})
+		})
+	}
+
+	_, err = TraverseTaskPar([]int{1, 2, 3, 4, 5, 6}, failing, 1).Run().Collect()
+	is.Equal(assert.AnError, err)
+	is.Equal(int32(1), atomic.LoadInt32(&runs))
+}
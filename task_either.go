@@ -1,13 +1,59 @@
 package mo
 
+import "context"
+
 // NewTaskEither instanciates a new TaskEither.
-func NewTaskEither[R any](f ff0[R]) TaskEither[R] {
-	return TaskEither[R]{NewTask[R](f)}
+func NewTaskEither[R any](f ff0[R], opts ...RunOption) TaskEither[R] {
+	return TaskEither[R]{NewTask[R](f, opts...)}
 }
 
 // NewTaskEitherFromIO instanciates a new TaskEither from an existing IO.
-func NewTaskEitherFromIO[R any](io IO[R]) TaskEither[R] {
-	return TaskEither[R]{NewTaskFromIO[R](io)}
+func NewTaskEitherFromIO[R any](io IO[R], opts ...RunOption) TaskEither[R] {
+	return TaskEither[R]{NewTaskFromIO[R](io, opts...)}
+}
+
+// NewTaskEitherFromIOEither instanciates a new TaskEither from an existing IOEither.
+func NewTaskEitherFromIOEither[R any](io IOEither[R], opts ...RunOption) TaskEither[R] {
+	return NewTaskEitherFromFunc[R](io.unsafePerform, opts...)
+}
+
+// NewTaskEitherFromFunc instanciates a new TaskEither from a function returning a value or an error.
+func NewTaskEitherFromFunc[R any](f func() (R, error), opts ...RunOption) TaskEither[R] {
+	options := newRunOptions(opts)
+	return TaskEither[R]{Task[R]{
+		unsafePerform: func() *Future[R] {
+			return newFutureWithOptions(settleTuple(f), options.inline())
+		},
+		options: options,
+	}}
+}
+
+// NewTaskEitherWithContext instanciates a new TaskEither from a function returning a value or
+// an error. Every run passes ctx to the function, and is rejected with `ctx.Err()` once ctx is done.
+func NewTaskEitherWithContext[R any](ctx context.Context, f func(context.Context) (R, error), opts ...RunOption) TaskEither[R] {
+	options := newRunOptions(opts)
+	return TaskEither[R]{Task[R]{
+		unsafePerform: func() *Future[R] {
+			return newFutureWithContext(ctx, func(ctx context.Context, resolve func(R), reject func(error)) {
+				settleTuple(func() (R, error) {
+					return f(ctx)
+				})(resolve, reject)
+			}, options.inline())
+		},
+		options: options,
+	}}
+}
+
+// settleTuple returns a Future callback settling the Future with the result of f.
+func settleTuple[R any](f func() (R, error)) func(resolve func(R), reject func(error)) {
+	return func(resolve func(R), reject func(error)) {
+		value, err := f()
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(value)
+	}
 }
 
 // TaskEither represents a non-deterministic asynchronous computation that
@@ -54,3 +100,123 @@ func (t TaskEither[R]) ToTask(fallback R) Task[R] {
 func (t TaskEither[R]) ToEither() Either[error, R] {
 	return t.Run().Either()
 }
+
+// NewTaskEither1 instanciates a new TaskEither1.
+func NewTaskEither1[R any, A any](f ff1[R, A], opts ...RunOption) TaskEither1[R, A] {
+	return TaskEither1[R, A]{NewTask1[R, A](f, opts...)}
+}
+
+// NewTaskEitherFromIOEither1 instanciates a new TaskEither1 from an existing IOEither1.
+func NewTaskEitherFromIOEither1[R any, A any](io IOEither1[R, A], opts ...RunOption) TaskEither1[R, A] {
+	options := newRunOptions(opts)
+	return TaskEither1[R, A]{Task1[R, A]{
+		unsafePerform: func(a A) *Future[R] {
+			return newFutureWithOptions(settleTuple(func() (R, error) {
+				return io.unsafePerform(a)
+			}), options.inline())
+		},
+		options: options,
+	}}
+}
+
+// TaskEither1 represents a non-deterministic asynchronous computation that
+// can cause side effects, yields a value of type `R` and can fail.
+type TaskEither1[R any, A any] struct {
+	Task1[R, A]
+}
+
+// NewTaskEither2 instanciates a new TaskEither2.
+func NewTaskEither2[R any, A any, B any](f ff2[R, A, B], opts ...RunOption) TaskEither2[R, A, B] {
+	return TaskEither2[R, A, B]{NewTask2[R, A, B](f, opts...)}
+}
+
+// NewTaskEitherFromIOEither2 instanciates a new TaskEither2 from an existing IOEither2.
+func NewTaskEitherFromIOEither2[R any, A any, B any](io IOEither2[R, A, B], opts ...RunOption) TaskEither2[R, A, B] {
+	options := newRunOptions(opts)
+	return TaskEither2[R, A, B]{Task2[R, A, B]{
+		unsafePerform: func(a A, b B) *Future[R] {
+			return newFutureWithOptions(settleTuple(func() (R, error) {
+				return io.unsafePerform(a, b)
+			}), options.inline())
+		},
+		options: options,
+	}}
+}
+
+// TaskEither2 represents a non-deterministic asynchronous computation that
+// can cause side effects, yields a value of type `R` and can fail.
+type TaskEither2[R any, A any, B any] struct {
+	Task2[R, A, B]
+}
+
+// NewTaskEither3 instanciates a new TaskEither3.
+func NewTaskEither3[R any, A any, B any, C any](f ff3[R, A, B, C], opts ...RunOption) TaskEither3[R, A, B, C] {
+	return TaskEither3[R, A, B, C]{NewTask3[R, A, B, C](f, opts...)}
+}
+
+// NewTaskEitherFromIOEither3 instanciates a new TaskEither3 from an existing IOEither3.
+func NewTaskEitherFromIOEither3[R any, A any, B any, C any](io IOEither3[R, A, B, C], opts ...RunOption) TaskEither3[R, A, B, C] {
+	options := newRunOptions(opts)
+	return TaskEither3[R, A, B, C]{Task3[R, A, B, C]{
+		unsafePerform: func(a A, b B, c C) *Future[R] {
+			return newFutureWithOptions(settleTuple(func() (R, error) {
+				return io.unsafePerform(a, b, c)
+			}), options.inline())
+		},
+		options: options,
+	}}
+}
+
+// TaskEither3 represents a non-deterministic asynchronous computation that
+// can cause side effects, yields a value of type `R` and can fail.
+type TaskEither3[R any, A any, B any, C any] struct {
+	Task3[R, A, B, C]
+}
+
+// NewTaskEither4 instanciates a new TaskEither4.
+func NewTaskEither4[R any, A any, B any, C any, D any](f ff4[R, A, B, C, D], opts ...RunOption) TaskEither4[R, A, B, C, D] {
+	return TaskEither4[R, A, B, C, D]{NewTask4[R, A, B, C, D](f, opts...)}
+}
+
+// NewTaskEitherFromIOEither4 instanciates a new TaskEither4 from an existing IOEither4.
+func NewTaskEitherFromIOEither4[R any, A any, B any, C any, D any](io IOEither4[R, A, B, C, D], opts ...RunOption) TaskEither4[R, A, B, C, D] {
+	options := newRunOptions(opts)
+	return TaskEither4[R, A, B, C, D]{Task4[R, A, B, C, D]{
+		unsafePerform: func(a A, b B, c C, d D) *Future[R] {
+			return newFutureWithOptions(settleTuple(func() (R, error) {
+				return io.unsafePerform(a, b, c, d)
+			}), options.inline())
+		},
+		options: options,
+	}}
+}
+
+// TaskEither4 represents a non-deterministic asynchronous computation that
+// can cause side effects, yields a value of type `R` and can fail.
+type TaskEither4[R any, A any, B any, C any, D any] struct {
+	Task4[R, A, B, C, D]
+}
+
+// NewTaskEither5 instanciates a new TaskEither5.
+func NewTaskEither5[R any, A any, B any, C any, D any, E any](f ff5[R, A, B, C, D, E], opts ...RunOption) TaskEither5[R, A, B, C, D, E] {
+	return TaskEither5[R, A, B, C, D, E]{NewTask5[R, A, B, C, D, E](f, opts...)}
+}
+
+// NewTaskEitherFromIOEither5 instanciates a new TaskEither5 from an existing IOEither5.
+func NewTaskEitherFromIOEither5[R any, A any, B any, C any, D any, E any](io IOEither5[R, A, B, C, D, E], opts ...RunOption) TaskEither5[R, A, B, C, D, E] {
+	options := newRunOptions(opts)
+	return TaskEither5[R, A, B, C, D, E]{Task5[R, A, B, C, D, E]{
+		unsafePerform: func(a A, b B, c C, d D, e E) *Future[R] {
+			return newFutureWithOptions(settleTuple(func() (R, error) {
+				return io.unsafePerform(a, b, c, d, e)
+			}), options.inline())
+		},
+		options: options,
+	}}
+}
+
+// TaskEither5 represents a non-deterministic asynchronous computation that
+// can cause side effects, yields a value of type `R` and can fail.
+type TaskEither5[R any, A any, B any, C any, D any, E any] struct {
+	Task5[R, A, B, C, D, E]
+}
@@ -0,0 +1,83 @@
+package mo
+
+// NewTaskEitherFromResult instanciates a new TaskEither yielding the value or the error of result.
+func NewTaskEitherFromResult[R any](result Result[R]) TaskEither[R] {
+	return NewTaskEitherFromFunc(result.Get)
+}
+
+// Map returns a TaskEither applying f to the value of t. Nothing runs until Run is called.
+func (t TaskEither[R]) Map(f func(R) R) TaskEither[R] {
+	return MapTaskEither(t, f)
+}
+
+// FlatMap returns a TaskEither running the TaskEither returned by f for the value of t.
+func (t TaskEither[R]) FlatMap(f func(R) TaskEither[R]) TaskEither[R] {
+	return FlatMapTaskEither(t, f)
+}
+
+// Tap returns a TaskEither calling f with the value of t, then yielding that value.
+// f is not called when t fails.
+func (t TaskEither[R]) Tap(f func(R)) TaskEither[R] {
+	return MapTaskEither(t, func(value R) R {
+		f(value)
+		return value
+	})
+}
+
+// MapError returns a TaskEither applying f to the error of t. The error of t is kept when
+// f returns nil: MapError cannot turn a failure into a success, see OrElseTaskEither.
+func (t TaskEither[R]) MapError(f func(error) error) TaskEither[R] {
+	return TaskEither[R]{deriveTask(t.Task, func(t Task[R]) *Future[R] {
+		return t.Run().Catch(func(err error) (R, error) {
+			if mapped := f(err); mapped != nil {
+				return empty[R](), mapped
+			}
+			return empty[R](), err
+		})
+	})}
+}
+
+// MapTaskEither returns a TaskEither applying f to the value of t. Nothing runs until Run is called.
+func MapTaskEither[A any, B any](t TaskEither[A], f func(A) B) TaskEither[B] {
+	return TaskEither[B]{MapTask(t.Task, f)}
+}
+
+// FlatMapTaskEither returns a TaskEither running the TaskEither returned by f for the value of t.
+func FlatMapTaskEither[A any, B any](t TaskEither[A], f func(A) TaskEither[B]) TaskEither[B] {
+	return TaskEither[B]{FlatMapTask(t.Task, func(value A) Task[B] {
+		return f(value).Task
+	})}
+}
+
+// OrElseTaskEither returns a TaskEither running the TaskEither returned by f for the error
+// of t. Unlike TaskEither.OrElse, it does not run anything until Run is called. The
+// TaskEither runs with the options of t.
+func OrElseTaskEither[R any](t TaskEither[R], f func(error) TaskEither[R]) TaskEither[R] {
+	return TaskEither[R]{deriveTask(t.Task, func(t Task[R]) *Future[R] {
+		return recoverFuture(t.Run(), func(err error) *Future[R] {
+			return f(err).Run()
+		})
+	})}
+}
+
+// ZipTaskEither returns a TaskEither running a and b concurrently, and yielding both values.
+// It fails with the error of a first, then with the error of b.
+func ZipTaskEither[A any, B any](a TaskEither[A], b TaskEither[B]) TaskEither[Tuple2[A, B]] {
+	return TaskEither[Tuple2[A, B]]{ZipTask(a.Task, b.Task)}
+}
+
+// SequenceTaskEither returns a TaskEither running every TaskEither concurrently, and yielding
+// their values in order. It fails with the first error.
+func SequenceTaskEither[R any](tasks []TaskEither[R]) TaskEither[[]R] {
+	return TraverseTaskEither(tasks, func(t TaskEither[R]) TaskEither[R] {
+		return t
+	})
+}
+
+// TraverseTaskEither returns a TaskEither running the TaskEither returned by f for every item
+// concurrently, and yielding their values in order. It fails with the first error.
+func TraverseTaskEither[A any, R any](items []A, f func(A) TaskEither[R]) TaskEither[[]R] {
+	return TaskEither[[]R]{TraverseTask(items, func(item A) Task[R] {
+		return f(item).Task
+	})}
+}
@@ -0,0 +1,103 @@
+package mo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskEitherCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	var runs int32
+	taskEither := NewTaskEitherFromFunc(func() (int, error) {
+		atomic.AddInt32(&runs, 1)
+		return 21, nil
+	})
+
+	var tapped int32
+	composed := taskEither.
+		Map(func(value int) int {
+			return value * 2
+		}).
+		FlatMap(func(value int) TaskEither[int] {
+			return NewTaskEitherFromResult(Ok(value + 1))
+		}).
+		Tap(func(value int) {
+			atomic.StoreInt32(&tapped, int32(value))
+		})
+	is.Equal(int32(0), atomic.LoadInt32(&runs))
+
+	is.Equal(Right[error, int](43), composed.ToEither())
+	is.Equal(int32(1), atomic.LoadInt32(&runs))
+	is.Equal(int32(43), atomic.LoadInt32(&tapped))
+
+	_, err := NewTaskEitherFromResult(Err[int](assert.AnError)).
+		Tap(func(value int) {
+			is.Fail("should not enter here")
+		}).
+		MapError(func(err error) error {
+			return fmt.Errorf("wrapped: %w", err)
+		}).
+		Run().Collect()
+	is.True(errors.Is(err, assert.AnError))
+	is.Equal("wrapped: assert.AnError general error for testing", err.Error())
+}
+
+func TestTaskEitherTypeChangingCombinators(t *testing.T) {
+	is := assert.New(t)
+
+	ok := NewTaskEitherFromResult(Ok(42))
+	ko := NewTaskEitherFromResult(Err[int](assert.AnError))
+
+	is.Equal(Ok("42"), MapTaskEither(ok, strconv.Itoa).Run().Result())
+	is.Equal(Err[string](assert.AnError), MapTaskEither(ko, strconv.Itoa).Run().Result())
+	is.Equal(Err[string](assert.AnError), FlatMapTaskEither(ok, func(value int) TaskEither[string] {
+		return NewTaskEitherFromResult(Err[string](assert.AnError))
+	}).Run().Result())
+
+	is.Equal(Ok(42), OrElseTaskEither(ok, func(err error) TaskEither[int] {
+		is.Fail("should not enter here")
+		return ko
+	}).Run().Result())
+	is.Equal(Ok(1), OrElseTaskEither(ko, func(err error) TaskEither[int] {
+		is.Equal(assert.AnError, err)
+		return NewTaskEitherFromResult(Ok(1))
+	}).Run().Result())
+
+	is.Equal(Ok(T2(42, "foo")), ZipTaskEither(ok, NewTaskEitherFromResult(Ok("foo"))).Run().Result())
+	is.Equal(Err[Tuple2[int, int]](assert.AnError), ZipTaskEither(ok, ko).Run().Result())
+
+	is.Equal(Ok([]int{42, 42}), SequenceTaskEither([]TaskEither[int]{ok, ok}).Run().Result())
+	is.Equal(Err[[]int](assert.AnError), TraverseTaskEither([]int{1, 2}, func(value int) TaskEither[int] {
+		if value == 2 {
+			return ko
+		}
+		return ok
+	}).Run().Result())
+}
+
+func TestTaskEitherCombinatorsOptions(t *testing.T) {
+	is := assert.New(t)
+
+	taskEither := NewTaskEitherFromResult(Err[int](assert.AnError))
+	taskEither.options = newRunOptions([]RunOption{WithPanicRecovery(false)})
+
+	is.Equal(taskEither.options, taskEither.MapError(func(err error) error {
+		return err
+	}).options)
+	is.Equal(taskEither.options, OrElseTaskEither(taskEither, func(err error) TaskEither[int] {
+		return taskEither
+	}).options)
+	is.Equal(taskEither.options, MapTaskEither(taskEither, strconv.Itoa).options)
+
+	// MapError cannot turn a failure into a success
+	_, err := taskEither.MapError(func(err error) error {
+		return nil
+	}).Run().Collect()
+	is.Equal(assert.AnError, err)
+}
@@ -1,6 +1,7 @@
 package mo
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -121,3 +122,88 @@ func TestTaskEitherToEither(t *testing.T) {
 	is.NotNil(err)
 	is.Equal(assert.AnError, err)
 }
+
+func TestTaskEitherFromIOEither(t *testing.T) {
+	is := assert.New(t)
+
+	var runs int
+	taskEither := NewTaskEitherFromIOEither(NewIOEither(func() (int, error) {
+		runs++
+		return 42, nil
+	}))
+	is.Equal(0, runs)
+
+	is.Equal(Right[error, int](42), taskEither.ToEither())
+	is.Equal(1, runs)
+
+	taskEither = NewTaskEitherFromIOEither(NewIOEither(func() (int, error) {
+		return 0, assert.AnError
+	}))
+	is.Equal(Left[error, int](assert.AnError), taskEither.ToEither())
+}
+
+func TestTaskEitherFromFunc(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(42, NewTaskEitherFromFunc(func() (int, error) {
+		return 42, nil
+	}).OrElse(1234))
+	is.Equal(1234, NewTaskEitherFromFunc(func() (int, error) {
+		return 42, assert.AnError
+	}).OrElse(1234))
+}
+
+func TestTaskEitherWithContext(t *testing.T) {
+	is := assert.New(t)
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, 42)
+
+	value, err := NewTaskEitherWithContext(ctx, func(ctx context.Context) (int, error) {
+		return ctx.Value(key{}).(int), nil
+	}).Run().Collect()
+	is.NoError(err)
+	is.Equal(42, value)
+
+	ctx, cancel := context.WithCancel(ctx)
+	taskEither := NewTaskEitherWithContext(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	future := taskEither.Run()
+	cancel()
+
+	_, err = future.Collect()
+	is.ErrorIs(err, context.Canceled)
+}
+
+func TestTaskEitherN(t *testing.T) {
+	is := assert.New(t)
+
+	taskEither1 := NewTaskEitherFromIOEither1(NewIOEither1(func(a int) (int, error) {
+		return a, nil
+	}))
+	is.Equal(Ok(1), taskEither1.Run(1).Result())
+
+	taskEither2 := NewTaskEither2(func(a int, b int) *Future[int] {
+		return NewFuture(func(resolve func(int), reject func(error)) {
+			resolve(a + b)
+		})
+	})
+	is.Equal(Ok(3), taskEither2.Run(1, 2).Result())
+
+	taskEither3 := NewTaskEitherFromIOEither3(NewIOEither3(func(a int, b int, c int) (int, error) {
+		return 0, assert.AnError
+	}))
+	is.Equal(Err[int](assert.AnError), taskEither3.Run(1, 2, 3).Result())
+
+	taskEither4 := NewTaskEitherFromIOEither4(NewIOEither4(func(a int, b int, c int, d int) (int, error) {
+		return a + b + c + d, nil
+	}))
+	is.Equal(Ok(10), taskEither4.Run(1, 2, 3, 4).Result())
+
+	taskEither5 := NewTaskEitherFromIOEither5(NewIOEither5(func(a int, b int, c int, d int, e int) (int, error) {
+		return a + b + c + d + e, nil
+	}))
+	is.Equal(Ok(15), taskEither5.Run(1, 2, 3, 4, 5).Result())
+}
@@ -0,0 +1,17 @@
+package mo
+
+// T2 builds a Tuple2 from a pair of values.
+func T2[A any, B any](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{A: a, B: b}
+}
+
+// Tuple2 represents a group of 2 values.
+type Tuple2[A any, B any] struct {
+	A A
+	B B
+}
+
+// Unpack returns values contained in the tuple.
+func (t Tuple2[A, B]) Unpack() (A, B) {
+	return t.A, t.B
+}
@@ -0,0 +1,22 @@
+package mo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestT2(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(Tuple2[int, string]{A: 42, B: "foo"}, T2(42, "foo"))
+}
+
+func TestTuple2Unpack(t *testing.T) {
+	is := assert.New(t)
+
+	a, b := T2(42, "foo").Unpack()
+
+	is.Equal(42, a)
+	is.Equal("foo", b)
+}
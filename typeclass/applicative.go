@@ -0,0 +1,14 @@
+package typeclass
+
+// Pointed is implemented by containers that can lift a single value, turning a `A` into a `F[A]`.
+type Pointed[A any, FA any] interface {
+	Of(a A) FA
+}
+
+// Applicative is a Functor that can lift values and apply a wrapped function `F[A -> B]`
+// (spelled FAB) to a wrapped value.
+type Applicative[A any, B any, FA any, FB any, FAB any] interface {
+	Functor[A, B, FA, FB]
+	Pointed[A, FA]
+	Ap(fab FAB, fa FA) FB
+}
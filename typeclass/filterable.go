@@ -1,5 +1,6 @@
 package typeclass
 
-type Filterable[T any] interface {
-	Filter(func(T) bool) T
+// Filterable is implemented by containers that can discard the values not matching a predicate.
+type Filterable[A any, FA any] interface {
+	Filter(fa FA, predicate func(A) bool) FA
 }
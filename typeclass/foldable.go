@@ -1,7 +1,7 @@
 package typeclass
 
-type Foldable[T any, R any] interface {
-	Map(func(T) R) R
-	FoldLeft(func(T) R) R
-	FoldRight(func(T) R) R
+// Foldable is implemented by containers that can be reduced to a single value of type B.
+type Foldable[A any, B any, FA any] interface {
+	FoldLeft(fa FA, initial B, f func(B, A) B) B
+	FoldRight(fa FA, initial B, f func(A, B) B) B
 }
@@ -0,0 +1,7 @@
+package typeclass
+
+// Functor is implemented by containers that can be mapped over, turning a `F[A]` into a `F[B]`.
+// Go generics have no higher-kinded types, so `F[A]` and `F[B]` are spelled out as FA and FB.
+type Functor[A any, B any, FA any, FB any] interface {
+	Map(fa FA, f func(A) B) FB
+}
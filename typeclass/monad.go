@@ -0,0 +1,8 @@
+package typeclass
+
+// Monad is an Applicative whose computations can be chained, the next one depending
+// on the value produced by the previous one.
+type Monad[A any, B any, FA any, FB any, FAB any] interface {
+	Applicative[A, B, FA, FB, FAB]
+	FlatMap(fa FA, f func(A) FB) FB
+}
@@ -1,4 +1,75 @@
 package typeclass
 
+// Monoid is a Semigroup having an identity element.
 type Monoid[T any] interface {
+	Semigroup[T]
+	Empty() T
+}
+
+// Number is a constraint matching all builtin numeric types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// NewMonoid builds a Monoid from an identity element and an associative function.
+func NewMonoid[T any](empty T, concat func(x T, y T) T) Monoid[T] {
+	return monoid[T]{
+		semigroup: semigroup[T]{concat: concat},
+		empty:     empty,
+	}
+}
+
+type monoid[T any] struct {
+	semigroup[T]
+	empty T
+}
+
+func (m monoid[T]) Empty() T {
+	return m.empty
+}
+
+// SumMonoid returns the Monoid of numbers under addition.
+func SumMonoid[T Number]() Monoid[T] {
+	return NewMonoid(0, func(x T, y T) T {
+		return x + y
+	})
+}
+
+// ProductMonoid returns the Monoid of numbers under multiplication.
+func ProductMonoid[T Number]() Monoid[T] {
+	return NewMonoid(1, func(x T, y T) T {
+		return x * y
+	})
+}
+
+// StringMonoid returns the Monoid of strings under concatenation.
+func StringMonoid() Monoid[string] {
+	return NewMonoid("", func(x string, y string) string {
+		return x + y
+	})
+}
+
+// SliceMonoid returns the Monoid of slices under concatenation.
+func SliceMonoid[T any]() Monoid[[]T] {
+	return NewMonoid([]T{}, func(x []T, y []T) []T {
+		result := make([]T, 0, len(x)+len(y))
+		result = append(result, x...)
+		return append(result, y...)
+	})
+}
+
+// AllMonoid returns the Monoid of booleans under conjunction.
+func AllMonoid() Monoid[bool] {
+	return NewMonoid(true, func(x bool, y bool) bool {
+		return x && y
+	})
+}
+
+// AnyMonoid returns the Monoid of booleans under disjunction.
+func AnyMonoid() Monoid[bool] {
+	return NewMonoid(false, func(x bool, y bool) bool {
+		return x || y
+	})
 }
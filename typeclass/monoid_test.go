@@ -0,0 +1,99 @@
+package typeclass
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func concatAll[T any](m Monoid[T], values ...T) T {
+	result := m.Empty()
+	for _, v := range values {
+		result = m.Concat(result, v)
+	}
+	return result
+}
+
+func TestNewSemigroup(t *testing.T) {
+	is := assert.New(t)
+
+	max := NewSemigroup(func(x int, y int) int {
+		if x > y {
+			return x
+		}
+		return y
+	})
+
+	is.Equal(42, max.Concat(21, 42))
+	is.Equal(42, max.Concat(42, 21))
+}
+
+func TestNewMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	max := NewMonoid[uint](0, func(x uint, y uint) uint {
+		if x > y {
+			return x
+		}
+		return y
+	})
+
+	is.Equal(uint(0), max.Empty())
+	is.Equal(uint(0), concatAll(max))
+	is.Equal(uint(42), concatAll(max, 21, 42, 7))
+
+	values := []uint{0, 1, 7, 42}
+	for _, x := range values {
+		is.Equal(x, max.Concat(max.Empty(), x))
+		is.Equal(x, max.Concat(x, max.Empty()))
+		for _, y := range values {
+			for _, z := range values {
+				is.Equal(max.Concat(max.Concat(x, y), z), max.Concat(x, max.Concat(y, z)))
+			}
+		}
+	}
+}
+
+func TestSumMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(0, SumMonoid[int]().Empty())
+	is.Equal(6, concatAll(SumMonoid[int](), 1, 2, 3))
+	is.Equal(1.5, concatAll(SumMonoid[float64](), 1, 0.5))
+}
+
+func TestProductMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(1, ProductMonoid[int]().Empty())
+	is.Equal(24, concatAll(ProductMonoid[int](), 2, 3, 4))
+}
+
+func TestStringMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal("", StringMonoid().Empty())
+	is.Equal("foobar", concatAll(StringMonoid(), "foo", "bar"))
+}
+
+func TestSliceMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	x := []int{1, 2}
+	result := concatAll(SliceMonoid[int](), x, []int{3}, []int{})
+
+	is.Equal([]int{}, SliceMonoid[int]().Empty())
+	is.Equal([]int{1, 2, 3}, result)
+	is.Equal([]int{1, 2}, x)
+}
+
+func TestAllAnyMonoid(t *testing.T) {
+	is := assert.New(t)
+
+	is.True(concatAll(AllMonoid()))
+	is.True(concatAll(AllMonoid(), true, true))
+	is.False(concatAll(AllMonoid(), true, false))
+	is.False(concatAll(AnyMonoid()))
+	is.True(concatAll(AnyMonoid(), false, true))
+	is.False(concatAll(AnyMonoid(), false, false))
+}
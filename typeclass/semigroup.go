@@ -0,0 +1,19 @@
+package typeclass
+
+// Semigroup is implemented by types having an associative binary operation.
+type Semigroup[T any] interface {
+	Concat(x T, y T) T
+}
+
+// NewSemigroup builds a Semigroup from an associative function.
+func NewSemigroup[T any](concat func(x T, y T) T) Semigroup[T] {
+	return semigroup[T]{concat: concat}
+}
+
+type semigroup[T any] struct {
+	concat func(T, T) T
+}
+
+func (s semigroup[T]) Concat(x T, y T) T {
+	return s.concat(x, y)
+}
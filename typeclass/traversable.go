@@ -0,0 +1,7 @@
+package typeclass
+
+// Traversable is implemented by containers that can be walked through while running an
+// applicative effect `G` on every value, turning a `F[A]` into a `G[F[B]]` (spelled GFB).
+type Traversable[A any, B any, FA any, GB any, GFB any] interface {
+	Traverse(fa FA, f func(A) GB) GFB
+}
@@ -0,0 +1,208 @@
+package mo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TypedOk builds a TypedResult when value is valid.
+func TypedOk[T any, E any](value T) TypedResult[T, E] {
+	return TypedResult[T, E]{
+		value: value,
+		isErr: false,
+	}
+}
+
+// TypedErr builds a TypedResult when value is invalid.
+func TypedErr[T any, E any](err E) TypedResult[T, E] {
+	return TypedResult[T, E]{
+		err:   err,
+		isErr: true,
+	}
+}
+
+// TypedResultFromEither converts an Either into a TypedResult, Left being the error.
+func TypedResultFromEither[E any, T any](either Either[E, T]) TypedResult[T, E] {
+	if either.IsLeft() {
+		return TypedErr[T](either.MustLeft())
+	}
+	return TypedOk[T, E](either.MustRight())
+}
+
+// TypedResultFromResult converts a Result into a TypedResult. The error of the Result is
+// looked up with errors.As. It returns false when the Result is invalid with an error
+// that is not a E.
+func TypedResultFromResult[T any, E error](result Result[T]) (TypedResult[T, E], bool) {
+	if !result.isErr {
+		return TypedOk[T, E](result.value), true
+	}
+
+	var typed *TypedError[E]
+	if errors.As(result.err, &typed) {
+		return TypedErr[T](typed.Err), true
+	}
+
+	var err E
+	if errors.As(result.err, &err) {
+		return TypedErr[T](err), true
+	}
+
+	return TypedResult[T, E]{}, false
+}
+
+// TypedResult respresent a result of an action having one
+// of the following output: success or failure of type E.
+// An instance of TypedResult is an instance of either TypedOk or TypedErr.
+// It could be compared to `Either[E, T]`.
+type TypedResult[T any, E any] struct {
+	isErr bool
+	value T
+	err   E
+}
+
+// IsOk returns true when value is valid.
+func (r TypedResult[T, E]) IsOk() bool {
+	return !r.isErr
+}
+
+// IsError returns true when value is invalid.
+func (r TypedResult[T, E]) IsError() bool {
+	return r.isErr
+}
+
+// Error returns error when value is invalid or empty value.
+func (r TypedResult[T, E]) Error() E {
+	return r.err
+}
+
+// Get returns value and error.
+func (r TypedResult[T, E]) Get() (T, E) {
+	if r.isErr {
+		return empty[T](), r.err
+	}
+
+	return r.value, empty[E]()
+}
+
+// MustGet returns value when TypedResult is valid or panics with a *TypedError.
+func (r TypedResult[T, E]) MustGet() T {
+	if r.isErr {
+		panic(&TypedError[E]{Err: r.err})
+	}
+
+	return r.value
+}
+
+// OrElse returns value when TypedResult is valid or default value.
+func (r TypedResult[T, E]) OrElse(fallback T) T {
+	if r.isErr {
+		return fallback
+	}
+
+	return r.value
+}
+
+// OrEmpty returns value when TypedResult is valid or empty value.
+func (r TypedResult[T, E]) OrEmpty() T {
+	return r.value
+}
+
+// ToEither transforms a TypedResult into an Either type.
+func (r TypedResult[T, E]) ToEither() Either[E, T] {
+	if r.isErr {
+		return Left[E, T](r.err)
+	}
+
+	return Right[E, T](r.value)
+}
+
+// ToResult transforms a TypedResult into a Result. The error is wrapped into a *TypedError,
+// so that errors.Is and errors.As reach it when E implements error.
+func (r TypedResult[T, E]) ToResult() Result[T] {
+	if r.isErr {
+		return Err[T](&TypedError[E]{Err: r.err})
+	}
+
+	return Ok(r.value)
+}
+
+// ForEach executes the given side-effecting function if TypedResult is valid.
+func (r TypedResult[T, E]) ForEach(mapper func(value T)) {
+	if !r.isErr {
+		mapper(r.value)
+	}
+}
+
+// Match executes the first function if TypedResult is valid and second function if invalid.
+// It returns a new TypedResult.
+func (r TypedResult[T, E]) Match(onSuccess func(value T) TypedResult[T, E], onError func(err E) TypedResult[T, E]) TypedResult[T, E] {
+	if r.isErr {
+		return onError(r.err)
+	}
+	return onSuccess(r.value)
+}
+
+// Map executes the mapper function if TypedResult is valid. It returns a new TypedResult.
+func (r TypedResult[T, E]) Map(mapper func(value T) T) TypedResult[T, E] {
+	return MapTypedResult(r, mapper)
+}
+
+// MapErr executes the mapper function if TypedResult is invalid. It returns a new TypedResult.
+func (r TypedResult[T, E]) MapErr(mapper func(err E) E) TypedResult[T, E] {
+	return MapErrTypedResult(r, mapper)
+}
+
+// FlatMap executes the mapper function if TypedResult is valid. It returns a new TypedResult.
+func (r TypedResult[T, E]) FlatMap(mapper func(value T) TypedResult[T, E]) TypedResult[T, E] {
+	return FlatMapTypedResult(r, mapper)
+}
+
+// MapTypedResult executes the mapper function if TypedResult is valid. It returns a new TypedResult.
+func MapTypedResult[T any, E any, U any](r TypedResult[T, E], mapper func(value T) U) TypedResult[U, E] {
+	if r.isErr {
+		return TypedErr[U](r.err)
+	}
+
+	return TypedOk[U, E](mapper(r.value))
+}
+
+// MapErrTypedResult executes the mapper function if TypedResult is invalid, possibly changing
+// the error type. It returns a new TypedResult.
+func MapErrTypedResult[T any, E any, F any](r TypedResult[T, E], mapper func(err E) F) TypedResult[T, F] {
+	if r.isErr {
+		return TypedErr[T](mapper(r.err))
+	}
+
+	return TypedOk[T, F](r.value)
+}
+
+// FlatMapTypedResult executes the mapper function if TypedResult is valid. It returns a new TypedResult.
+func FlatMapTypedResult[T any, E any, U any](r TypedResult[T, E], mapper func(value T) TypedResult[U, E]) TypedResult[U, E] {
+	if r.isErr {
+		return TypedErr[U](r.err)
+	}
+
+	return mapper(r.value)
+}
+
+// TypedError wraps the error of a TypedResult into an error. Unwrap returns the error
+// itself when E implements error.
+type TypedError[E any] struct {
+	Err E
+}
+
+// Error returns the message of the wrapped error.
+func (e *TypedError[E]) Error() string {
+	if err, ok := any(e.Err).(error); ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("%v", e.Err)
+}
+
+// Unwrap returns the wrapped error, or nil when E does not implement error.
+func (e *TypedError[E]) Unwrap() error {
+	if err, ok := any(e.Err).(error); ok {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package mo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errCardDeclined = errors.New("card declined")
+
+// testBillingError is a domain error wrapping its cause.
+type testBillingError struct {
+	code  string
+	cause error
+}
+
+func (e *testBillingError) Error() string {
+	return e.code + ": " + e.cause.Error()
+}
+
+func (e *testBillingError) Unwrap() error {
+	return e.cause
+}
+
+// testErrorCode is a domain error that does not implement error.
+type testErrorCode int
+
+func TestTypedResult(t *testing.T) {
+	is := assert.New(t)
+
+	billingErr := &testBillingError{code: "declined", cause: errCardDeclined}
+
+	ok := TypedOk[int, *testBillingError](42)
+	ko := TypedErr[int](billingErr)
+
+	is.True(ok.IsOk())
+	is.False(ok.IsError())
+	is.False(ko.IsOk())
+	is.True(ko.IsError())
+
+	is.Nil(ok.Error())
+	is.Equal(billingErr, ko.Error())
+
+	value, err := ok.Get()
+	is.Equal(42, value)
+	is.Nil(err)
+	value, err = ko.Get()
+	is.Equal(0, value)
+	is.Equal(billingErr, err)
+
+	is.Equal(42, ok.MustGet())
+	is.PanicsWithError("declined: card declined", func() {
+		ko.MustGet()
+	})
+
+	is.Equal(42, ok.OrElse(1))
+	is.Equal(1, ko.OrElse(1))
+	is.Equal(42, ok.OrEmpty())
+	is.Equal(0, ko.OrEmpty())
+
+	calls := 0
+	ok.ForEach(func(value int) {
+		calls += value
+	})
+	ko.ForEach(func(value int) {
+		calls += value
+	})
+	is.Equal(42, calls)
+}
+
+func TestTypedResultMethods(t *testing.T) {
+	is := assert.New(t)
+
+	ok := TypedOk[int, testErrorCode](21)
+	ko := TypedErr[int](testErrorCode(404))
+
+	is.Equal(TypedOk[int, testErrorCode](42), ok.Map(func(value int) int {
+		return value * 2
+	}))
+	is.Equal(ko, ko.Map(func(value int) int {
+		is.Fail("should not enter here")
+		return value
+	}))
+
+	is.Equal(ok, ok.MapErr(func(err testErrorCode) testErrorCode {
+		is.Fail("should not enter here")
+		return err
+	}))
+	is.Equal(TypedErr[int](testErrorCode(500)), ko.MapErr(func(err testErrorCode) testErrorCode {
+		return err + 96
+	}))
+
+	is.Equal(TypedErr[int](testErrorCode(400)), ok.FlatMap(func(value int) TypedResult[int, testErrorCode] {
+		return TypedErr[int](testErrorCode(400))
+	}))
+	is.Equal(ko, ko.FlatMap(func(value int) TypedResult[int, testErrorCode] {
+		is.Fail("should not enter here")
+		return ok
+	}))
+
+	onSuccess := func(value int) TypedResult[int, testErrorCode] {
+		return TypedOk[int, testErrorCode](value + 1)
+	}
+	onError := func(err testErrorCode) TypedResult[int, testErrorCode] {
+		return TypedOk[int, testErrorCode](int(err))
+	}
+	is.Equal(TypedOk[int, testErrorCode](22), ok.Match(onSuccess, onError))
+	is.Equal(TypedOk[int, testErrorCode](404), ko.Match(onSuccess, onError))
+}
+
+func TestTypedResultComposition(t *testing.T) {
+	is := assert.New(t)
+
+	ok := TypedOk[int, testErrorCode](42)
+	ko := TypedErr[int](testErrorCode(404))
+
+	is.Equal(TypedOk[string, testErrorCode]("42"), MapTypedResult(ok, func(value int) string {
+		return fmt.Sprint(value)
+	}))
+	is.Equal(TypedErr[string](testErrorCode(404)), MapTypedResult(ko, func(value int) string {
+		return fmt.Sprint(value)
+	}))
+
+	is.Equal(TypedOk[int, string](42), MapErrTypedResult(ok, func(err testErrorCode) string {
+		return fmt.Sprint(err)
+	}))
+	is.Equal(TypedErr[int]("404"), MapErrTypedResult(ko, func(err testErrorCode) string {
+		return fmt.Sprint(err)
+	}))
+
+	is.Equal(TypedOk[string, testErrorCode]("42!"), FlatMapTypedResult(ok, func(value int) TypedResult[string, testErrorCode] {
+		return TypedOk[string, testErrorCode](fmt.Sprint(value) + "!")
+	}))
+	is.Equal(TypedErr[string](testErrorCode(404)), FlatMapTypedResult(ko, func(value int) TypedResult[string, testErrorCode] {
+		is.Fail("should not enter here")
+		return TypedOk[string, testErrorCode]("")
+	}))
+}
+
+func TestTypedResultConversions(t *testing.T) {
+	is := assert.New(t)
+
+	billingErr := &testBillingError{code: "declined", cause: errCardDeclined}
+
+	is.Equal(Right[*testBillingError, int](42), TypedOk[int, *testBillingError](42).ToEither())
+	is.Equal(Left[*testBillingError, int](billingErr), TypedErr[int](billingErr).ToEither())
+	is.Equal(TypedOk[int, *testBillingError](42), TypedResultFromEither(Right[*testBillingError, int](42)))
+	is.Equal(TypedErr[int](billingErr), TypedResultFromEither(Left[*testBillingError, int](billingErr)))
+
+	is.Equal(Ok(42), TypedOk[int, *testBillingError](42).ToResult())
+
+	err := TypedErr[int](billingErr).ToResult().Error()
+	is.EqualError(err, "declined: card declined")
+	is.ErrorIs(err, errCardDeclined)
+	var target *testBillingError
+	is.True(errors.As(err, &target))
+	is.Equal(billingErr, target)
+
+	typed, ok := TypedResultFromResult[int, *testBillingError](TypedErr[int](billingErr).ToResult())
+	is.True(ok)
+	is.Equal(TypedErr[int](billingErr), typed)
+
+	typed, ok = TypedResultFromResult[int, *testBillingError](Err[int](fmt.Errorf("charge: %w", billingErr)))
+	is.True(ok)
+	is.Equal(TypedErr[int](billingErr), typed)
+
+	typed, ok = TypedResultFromResult[int, *testBillingError](Ok(42))
+	is.True(ok)
+	is.Equal(TypedOk[int, *testBillingError](42), typed)
+
+	_, ok = TypedResultFromResult[int, *testBillingError](Err[int](assert.AnError))
+	is.False(ok)
+}
+
+func TestTypedError(t *testing.T) {
+	is := assert.New(t)
+
+	err := TypedErr[int](testErrorCode(404)).ToResult().Error()
+	is.EqualError(err, "404")
+	is.Nil(errors.Unwrap(err))
+
+	var typed *TypedError[testErrorCode]
+	is.True(errors.As(err, &typed))
+	is.Equal(testErrorCode(404), typed.Err)
+
+	err = TypedErr[int](errCardDeclined).ToResult().Error()
+	is.ErrorIs(err, errCardDeclined)
+	is.Equal(errCardDeclined, errors.Unwrap(err))
+}
@@ -0,0 +1,208 @@
+package mo
+
+import "strings"
+
+// Valid builds a Validated when value is valid.
+func Valid[E any, A any](value A) Validated[E, A] {
+	return Validated[E, A]{
+		value: value,
+	}
+}
+
+// Invalid builds a Validated failing with at least one error.
+func Invalid[A any, E any](err E, errs ...E) Validated[E, A] {
+	return Validated[E, A]{
+		errs: append([]E{err}, errs...),
+	}
+}
+
+// ValidatedFromEither converts an Either into a Validated, Left being the error.
+func ValidatedFromEither[E any, A any](either Either[E, A]) Validated[E, A] {
+	if either.IsLeft() {
+		return Invalid[A](either.MustLeft())
+	}
+	return Valid[E](either.MustRight())
+}
+
+// ValidatedFromResult converts a Result into a Validated.
+func ValidatedFromResult[A any](result Result[A]) Validated[error, A] {
+	if result.isErr {
+		return Invalid[A](result.err)
+	}
+	return Valid[error](result.value)
+}
+
+// Validated respresent a validated value or the non-empty list of errors found while
+// validating it. Unlike Result, combining Validated values with ApValidated, ZipValidated
+// or TraverseValidated accumulates every error instead of stopping at the first one.
+type Validated[E any, A any] struct {
+	errs  []E
+	value A
+}
+
+// IsValid returns true when value is valid.
+func (v Validated[E, A]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// IsInvalid returns true when value is invalid.
+func (v Validated[E, A]) IsInvalid() bool {
+	return len(v.errs) > 0
+}
+
+// Errors returns the errors when value is invalid or nil.
+func (v Validated[E, A]) Errors() []E {
+	return v.errs
+}
+
+// Get returns value and errors.
+func (v Validated[E, A]) Get() (A, []E) {
+	if v.IsInvalid() {
+		return empty[A](), v.errs
+	}
+	return v.value, nil
+}
+
+// OrElse returns value when Validated is valid or default value.
+func (v Validated[E, A]) OrElse(fallback A) A {
+	if v.IsInvalid() {
+		return fallback
+	}
+	return v.value
+}
+
+// ToEither transforms a Validated into an Either type.
+func (v Validated[E, A]) ToEither() Either[[]E, A] {
+	if v.IsInvalid() {
+		return Left[[]E, A](v.errs)
+	}
+	return Right[[]E, A](v.value)
+}
+
+// Map executes the mapper function if Validated is valid. It returns a new Validated.
+func (v Validated[E, A]) Map(mapper func(value A) A) Validated[E, A] {
+	return MapValidated(v, mapper)
+}
+
+// MapErrors executes the mapper function on every error. It returns a new Validated.
+func (v Validated[E, A]) MapErrors(mapper func(err E) E) Validated[E, A] {
+	if v.IsValid() {
+		return v
+	}
+
+	errs := make([]E, 0, len(v.errs))
+	for _, err := range v.errs {
+		errs = append(errs, mapper(err))
+	}
+	return Validated[E, A]{errs: errs}
+}
+
+// ValidatedToResult transforms a Validated into a Result. The errors are joined, so that
+// errors.Is and errors.As reach each of them.
+func ValidatedToResult[E error, A any](v Validated[E, A]) Result[A] {
+	if v.IsValid() {
+		return Ok(v.value)
+	}
+
+	errs := make([]error, 0, len(v.errs))
+	for _, err := range v.errs {
+		errs = append(errs, err)
+	}
+	return Err[A](joinErrors(errs...))
+}
+
+// MapValidated executes the mapper function if Validated is valid. It returns a new Validated.
+func MapValidated[E any, A any, B any](v Validated[E, A], mapper func(value A) B) Validated[E, B] {
+	if v.IsInvalid() {
+		return Validated[E, B]{errs: v.errs}
+	}
+	return Valid[E](mapper(v.value))
+}
+
+// FlatMapValidated executes the mapper function if Validated is valid. It returns a new
+// Validated. Like Result.FlatMap, it stops at the first invalid value: use it for checks
+// depending on a valid value, and ApValidated for independent checks.
+func FlatMapValidated[E any, A any, B any](v Validated[E, A], mapper func(value A) Validated[E, B]) Validated[E, B] {
+	if v.IsInvalid() {
+		return Validated[E, B]{errs: v.errs}
+	}
+	return mapper(v.value)
+}
+
+// ApValidated applies the function of vf to the value of va. When both are invalid,
+// the errors of vf are followed by the errors of va.
+func ApValidated[E any, A any, B any](vf Validated[E, func(A) B], va Validated[E, A]) Validated[E, B] {
+	if vf.IsInvalid() || va.IsInvalid() {
+		errs := make([]E, 0, len(vf.errs)+len(va.errs))
+		errs = append(errs, vf.errs...)
+		return Validated[E, B]{errs: append(errs, va.errs...)}
+	}
+	return Valid[E](vf.value(va.value))
+}
+
+// ZipValidated combines the values of a and b, accumulating their errors.
+func ZipValidated[E any, A any, B any](a Validated[E, A], b Validated[E, B]) Validated[E, Tuple2[A, B]] {
+	return ApValidated(MapValidated(a, func(valueA A) func(B) Tuple2[A, B] {
+		return func(valueB B) Tuple2[A, B] {
+			return T2(valueA, valueB)
+		}
+	}), b)
+}
+
+// SequenceValidated combines the values of every Validated in order, accumulating their errors.
+func SequenceValidated[E any, A any](validated []Validated[E, A]) Validated[E, []A] {
+	return TraverseValidated(validated, func(v Validated[E, A]) Validated[E, A] {
+		return v
+	})
+}
+
+// TraverseValidated validates every item with f in order, and combines the values,
+// accumulating the errors.
+func TraverseValidated[E any, A any, B any](items []A, f func(A) Validated[E, B]) Validated[E, []B] {
+	values := make([]B, 0, len(items))
+	var errs []E
+	for _, item := range items {
+		v := f(item)
+		if v.IsInvalid() {
+			errs = append(errs, v.errs...)
+			continue
+		}
+		values = append(values, v.value)
+	}
+
+	if len(errs) > 0 {
+		return Validated[E, []B]{errs: errs}
+	}
+	return Valid[E](values)
+}
+
+// AtField annotates every error of v with the path of field. Annotating errors already
+// annotated prefixes their path, so that nested validations report paths such as
+// `address.street` or `items[0].price`.
+func AtField[A any](field string, v Validated[error, A]) Validated[error, A] {
+	return v.MapErrors(func(err error) error {
+		if fieldErr, ok := err.(*FieldError); ok {
+			if strings.HasPrefix(fieldErr.Path, "[") {
+				return &FieldError{Path: field + fieldErr.Path, Err: fieldErr.Err}
+			}
+			return &FieldError{Path: field + "." + fieldErr.Path, Err: fieldErr.Err}
+		}
+		return &FieldError{Path: field, Err: err}
+	})
+}
+
+// FieldError is an error annotated with the path of the invalid field.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+// Error returns the path followed by the message of the wrapped error.
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
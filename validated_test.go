@@ -0,0 +1,186 @@
+package mo
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errRequired = errors.New("required")
+	errTooShort = errors.New("too short")
+)
+
+func TestValidated(t *testing.T) {
+	is := assert.New(t)
+
+	valid := Valid[error](42)
+	invalid := Invalid[int](errRequired, errTooShort)
+
+	is.True(valid.IsValid())
+	is.False(valid.IsInvalid())
+	is.False(invalid.IsValid())
+	is.True(invalid.IsInvalid())
+
+	is.Nil(valid.Errors())
+	is.Equal([]error{errRequired, errTooShort}, invalid.Errors())
+
+	value, errs := valid.Get()
+	is.Equal(42, value)
+	is.Nil(errs)
+	value, errs = invalid.Get()
+	is.Equal(0, value)
+	is.Equal([]error{errRequired, errTooShort}, errs)
+
+	is.Equal(42, valid.OrElse(1))
+	is.Equal(1, invalid.OrElse(1))
+
+	is.Equal(Valid[error](84), valid.Map(func(value int) int {
+		return value * 2
+	}))
+	is.Equal(invalid, invalid.Map(func(value int) int {
+		is.Fail("should not enter here")
+		return value
+	}))
+
+	is.Equal(valid, valid.MapErrors(func(err error) error {
+		is.Fail("should not enter here")
+		return err
+	}))
+	is.Equal(Invalid[int](assert.AnError, assert.AnError), invalid.MapErrors(func(err error) error {
+		return assert.AnError
+	}))
+}
+
+func TestValidatedConversions(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(Right[[]string, int](42), Valid[string](42).ToEither())
+	is.Equal(Left[[]string, int]([]string{"a", "b"}), Invalid[int]("a", "b").ToEither())
+
+	is.Equal(Valid[string](42), ValidatedFromEither(Right[string, int](42)))
+	is.Equal(Invalid[int]("a"), ValidatedFromEither(Left[string, int]("a")))
+
+	is.Equal(Valid[error](42), ValidatedFromResult(Ok(42)))
+	is.Equal(Invalid[int](assert.AnError), ValidatedFromResult(Err[int](assert.AnError)))
+
+	is.Equal(Ok(42), ValidatedToResult(Valid[error](42)))
+	is.Equal(Err[int](errRequired), ValidatedToResult(Invalid[int](errRequired)))
+
+	err := ValidatedToResult(Invalid[int](errRequired, errTooShort)).Error()
+	is.ErrorIs(err, errRequired)
+	is.ErrorIs(err, errTooShort)
+	is.EqualError(err, "required\ntoo short")
+}
+
+func TestValidatedComposition(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(Valid[string]("42"), MapValidated(Valid[string](42), func(value int) string {
+		return "42"
+	}))
+	is.Equal(Invalid[string]("a"), MapValidated(Invalid[int]("a"), func(value int) string {
+		is.Fail("should not enter here")
+		return ""
+	}))
+
+	is.Equal(Invalid[string]("b"), FlatMapValidated(Valid[string](42), func(value int) Validated[string, string] {
+		return Invalid[string]("b")
+	}))
+	is.Equal(Invalid[string]("a"), FlatMapValidated(Invalid[int]("a"), func(value int) Validated[string, string] {
+		is.Fail("should not enter here")
+		return Invalid[string]("b")
+	}))
+
+	double := Valid[string](func(value int) int {
+		return value * 2
+	})
+	is.Equal(Valid[string](84), ApValidated(double, Valid[string](42)))
+	is.Equal(Invalid[int]("b"), ApValidated(double, Invalid[int]("b")))
+	is.Equal(Invalid[int]("a", "b", "c"), ApValidated(Invalid[func(int) int]("a"), Invalid[int]("b", "c")))
+
+	is.Equal(Valid[string](T2(1, "foo")), ZipValidated(Valid[string](1), Valid[string]("foo")))
+	is.Equal(Invalid[Tuple2[int, string]]("a", "b"), ZipValidated(Invalid[int]("a"), Invalid[string]("b")))
+
+	is.Equal(Valid[string]([]int{1, 2}), SequenceValidated([]Validated[string, int]{Valid[string](1), Valid[string](2)}))
+	is.Equal(Invalid[[]int]("a", "b"), SequenceValidated([]Validated[string, int]{Invalid[int]("a"), Valid[string](2), Invalid[int]("b")}))
+	is.Equal(Valid[string]([]int{}), SequenceValidated([]Validated[string, int]{}))
+
+	is.Equal(Invalid[[]int]("-1", "-3"), TraverseValidated([]int{-1, 2, -3}, func(value int) Validated[string, int] {
+		if value < 0 {
+			return Invalid[int](strconv.Itoa(value))
+		}
+		return Valid[string](value)
+	}))
+}
+
+type testAddress struct {
+	street string
+	city   string
+}
+
+type testSignup struct {
+	name    string
+	address testAddress
+}
+
+func TestValidatedForm(t *testing.T) {
+	is := assert.New(t)
+
+	required := func(value string) Validated[error, string] {
+		if value == "" {
+			return Invalid[string](errRequired)
+		}
+		return Valid[error](value)
+	}
+	minLength := func(value string) Validated[error, string] {
+		if len(value) < 3 {
+			return Invalid[string](errTooShort)
+		}
+		return Valid[error](value)
+	}
+
+	validateAddress := func(address testAddress) Validated[error, testAddress] {
+		return MapValidated(ZipValidated(
+			AtField("street", required(address.street)),
+			AtField("city", FlatMapValidated(required(address.city), minLength)),
+		), func(fields Tuple2[string, string]) testAddress {
+			return testAddress{street: fields.A, city: fields.B}
+		})
+	}
+	validateSignup := func(signup testSignup) Validated[error, testSignup] {
+		return MapValidated(ZipValidated(
+			AtField("name", minLength(signup.name)),
+			AtField("address", validateAddress(signup.address)),
+		), func(fields Tuple2[string, testAddress]) testSignup {
+			return testSignup{name: fields.A, address: fields.B}
+		})
+	}
+
+	signup := testSignup{name: "alice", address: testAddress{street: "main st", city: "Paris"}}
+	is.Equal(Valid[error](signup), validateSignup(signup))
+
+	result := validateSignup(testSignup{name: "al", address: testAddress{city: "NY"}})
+	is.True(result.IsInvalid())
+
+	paths := []string{}
+	for _, err := range result.Errors() {
+		var fieldErr *FieldError
+		is.True(errors.As(err, &fieldErr))
+		paths = append(paths, fieldErr.Path)
+	}
+	is.Equal([]string{"name", "address.street", "address.city"}, paths)
+
+	err := ValidatedToResult(result).Error()
+	is.EqualError(err, "name: too short\naddress.street: required\naddress.city: too short")
+	is.ErrorIs(err, errRequired)
+	is.ErrorIs(err, errTooShort)
+
+	items := []string{"foo", ""}
+	validated := TraverseValidated([]int{0, 1}, func(index int) Validated[error, string] {
+		return AtField("["+strconv.Itoa(index)+"]", required(items[index]))
+	})
+	is.EqualError(ValidatedToResult(AtField("items", validated)).Error(), "items[1]: required")
+}
@@ -0,0 +1,121 @@
+package mo
+
+import "github.com/tperdue321/mo/typeclass"
+
+// NewWriter instanciates a new Writer yielding value and writing log.
+func NewWriter[W any, A any](monoid typeclass.Monoid[W], value A, log W) Writer[W, A] {
+	return Writer[W, A]{
+		monoid: monoid,
+		run: func() (A, W) {
+			return value, log
+		},
+	}
+}
+
+// ReturnWriter instanciates a new Writer yielding x and writing the empty log of monoid.
+func ReturnWriter[W any, A any](monoid typeclass.Monoid[W], x A) Writer[W, A] {
+	return NewWriter(monoid, x, monoid.Empty())
+}
+
+// Tell returns a Writer writing log.
+func Tell[W any](monoid typeclass.Monoid[W], log W) Writer[W, struct{}] {
+	return NewWriter(monoid, struct{}{}, log)
+}
+
+// Writer represents a computation `() -> (A, W)`, where `A` is result and `W` is a log
+// accumulated with a Monoid, such as a slice of events or counters.
+type Writer[W any, A any] struct {
+	monoid typeclass.Monoid[W]
+	run    func() (A, W)
+}
+
+// Run executes the computation and returns its result with the accumulated log.
+func (w Writer[W, A]) Run() (A, W) {
+	return w.run()
+}
+
+// Tell appends log after the log of the computation. The result is kept.
+func (w Writer[W, A]) Tell(log W) Writer[W, A] {
+	return Writer[W, A]{
+		monoid: w.monoid,
+		run: func() (A, W) {
+			result, written := w.run()
+			return result, w.monoid.Concat(written, log)
+		},
+	}
+}
+
+// Censor applies a function to the log of the computation.
+func (w Writer[W, A]) Censor(f func(W) W) Writer[W, A] {
+	return Writer[W, A]{
+		monoid: w.monoid,
+		run: func() (A, W) {
+			result, written := w.run()
+			return result, f(written)
+		},
+	}
+}
+
+// Map applies a function to the result of the computation.
+func (w Writer[W, A]) Map(f func(A) A) Writer[W, A] {
+	return MapWriter(w, f)
+}
+
+// FlatMap runs the computation returned by f for the result of the computation, and
+// appends its log.
+func (w Writer[W, A]) FlatMap(f func(A) Writer[W, A]) Writer[W, A] {
+	return FlatMapWriter(w, f)
+}
+
+// ListenWriter returns a Writer yielding the result of w with its log, and writing that log.
+func ListenWriter[W any, A any](w Writer[W, A]) Writer[W, Tuple2[A, W]] {
+	return Writer[W, Tuple2[A, W]]{
+		monoid: w.monoid,
+		run: func() (Tuple2[A, W], W) {
+			result, written := w.run()
+			return T2(result, written), written
+		},
+	}
+}
+
+// MapWriter applies a function to the result of the computation.
+func MapWriter[W any, A any, B any](w Writer[W, A], f func(A) B) Writer[W, B] {
+	return Writer[W, B]{
+		monoid: w.monoid,
+		run: func() (B, W) {
+			result, written := w.run()
+			return f(result), written
+		},
+	}
+}
+
+// FlatMapWriter runs the computation returned by f for the result of the computation,
+// and appends its log.
+func FlatMapWriter[W any, A any, B any](w Writer[W, A], f func(A) Writer[W, B]) Writer[W, B] {
+	return Writer[W, B]{
+		monoid: w.monoid,
+		run: func() (B, W) {
+			result, written := w.run()
+			next, nextWritten := f(result).run()
+			return next, w.monoid.Concat(written, nextWritten)
+		},
+	}
+}
+
+// SequenceWriter runs the computations in order, and yields their results with their
+// logs appended.
+func SequenceWriter[W any, A any](monoid typeclass.Monoid[W], writers []Writer[W, A]) Writer[W, []A] {
+	return Writer[W, []A]{
+		monoid: monoid,
+		run: func() ([]A, W) {
+			results := make([]A, 0, len(writers))
+			written := monoid.Empty()
+			for _, w := range writers {
+				result, log := w.run()
+				results = append(results, result)
+				written = monoid.Concat(written, log)
+			}
+			return results, written
+		},
+	}
+}
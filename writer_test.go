@@ -0,0 +1,111 @@
+package mo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tperdue321/mo/typeclass"
+)
+
+func TestWriter(t *testing.T) {
+	is := assert.New(t)
+
+	events := typeclass.SliceMonoid[string]()
+
+	result, log := NewWriter(events, 42, []string{"created"}).Run()
+	is.Equal(42, result)
+	is.Equal([]string{"created"}, log)
+
+	result, log = ReturnWriter(events, 42).Run()
+	is.Equal(42, result)
+	is.Equal([]string{}, log)
+
+	unit, log := Tell(events, []string{"told"}).Run()
+	is.Equal(struct{}{}, unit)
+	is.Equal([]string{"told"}, log)
+}
+
+func TestWriterMethods(t *testing.T) {
+	is := assert.New(t)
+
+	events := typeclass.SliceMonoid[string]()
+	writer := NewWriter(events, 21, []string{"created"})
+
+	result, log := writer.Tell([]string{"charged"}).Run()
+	is.Equal(21, result)
+	is.Equal([]string{"created", "charged"}, log)
+
+	result, log = writer.Censor(func(log []string) []string {
+		return []string{"redacted"}
+	}).Run()
+	is.Equal(21, result)
+	is.Equal([]string{"redacted"}, log)
+
+	result, log = writer.Map(func(result int) int {
+		return result * 2
+	}).Run()
+	is.Equal(42, result)
+	is.Equal([]string{"created"}, log)
+
+	result, log = writer.FlatMap(func(result int) Writer[[]string, int] {
+		return NewWriter(events, result*2, []string{"doubled"})
+	}).Run()
+	is.Equal(42, result)
+	is.Equal([]string{"created", "doubled"}, log)
+}
+
+func TestWriterComposition(t *testing.T) {
+	is := assert.New(t)
+
+	counter := typeclass.SumMonoid[int]()
+	writer := NewWriter(counter, "foo", 1)
+
+	listened, total := ListenWriter(writer.Tell(2)).Run()
+	is.Equal(T2("foo", 3), listened)
+	is.Equal(3, total)
+
+	length, total := MapWriter(writer, func(result string) int {
+		return len(result)
+	}).Run()
+	is.Equal(3, length)
+	is.Equal(1, total)
+
+	upper, total := FlatMapWriter(writer, func(result string) Writer[int, string] {
+		return NewWriter(counter, strings.ToUpper(result), 10)
+	}).Run()
+	is.Equal("FOO", upper)
+	is.Equal(11, total)
+
+	results, total := SequenceWriter(counter, []Writer[int, string]{writer, writer.Tell(5)}).Run()
+	is.Equal([]string{"foo", "foo"}, results)
+	is.Equal(7, total)
+
+	results, total = SequenceWriter(counter, []Writer[int, string]{}).Run()
+	is.Equal([]string{}, results)
+	is.Equal(0, total)
+}
+
+func TestWriterAuditTrail(t *testing.T) {
+	is := assert.New(t)
+
+	audit := typeclass.SliceMonoid[string]()
+
+	applyDiscount := func(amount int) Writer[[]string, int] {
+		if amount > 100 {
+			return NewWriter(audit, amount-10, []string{"discount applied"})
+		}
+		return ReturnWriter(audit, amount)
+	}
+	addTax := func(amount int) Writer[[]string, int] {
+		return NewWriter(audit, amount+amount/5, []string{"tax added"})
+	}
+
+	total, trail := ReturnWriter(audit, 150).FlatMap(applyDiscount).FlatMap(addTax).Run()
+	is.Equal(168, total)
+	is.Equal([]string{"discount applied", "tax added"}, trail)
+
+	total, trail = ReturnWriter(audit, 50).FlatMap(applyDiscount).FlatMap(addTax).Run()
+	is.Equal(60, total)
+	is.Equal([]string{"tax added"}, trail)
+}